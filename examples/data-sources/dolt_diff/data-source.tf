@@ -0,0 +1,28 @@
+terraform {
+  required_providers {
+    dolt = {
+      source = "marcbran/dolt"
+    }
+  }
+}
+
+provider "dolt" {
+  path  = "."
+  name  = "John Doe"
+  email = "john.doe@example.com"
+}
+
+data "dolt_diff" "release" {
+  database = "main"
+  from     = "v1.0.0"
+  to       = "main"
+  table    = "articles"
+}
+
+output "changed_articles" {
+  value = [for row in data.dolt_diff.release.rows : coalesce(row.to, row.from)["title"]]
+}
+
+output "changed_tables" {
+  value = { for table in data.dolt_diff.release.summary : table.table => table.rows_added + table.rows_deleted + table.rows_modified }
+}
@@ -0,0 +1,25 @@
+terraform {
+  required_providers {
+    dolt = {
+      source = "marcbran/dolt"
+    }
+  }
+}
+
+provider "dolt" {
+  path  = "."
+  name  = "John Doe"
+  email = "john.doe@example.com"
+}
+
+data "dolt_log" "articles" {
+  database = "main"
+  ref      = "main"
+  tables   = ["articles"]
+  since    = "2024-01-01"
+  limit    = 10
+}
+
+output "last_changed_by" {
+  value = try(data.dolt_log.articles.commits[0].committer, null)
+}
@@ -0,0 +1,25 @@
+terraform {
+  required_providers {
+    dolt = {
+      source = "marcbran/dolt"
+    }
+  }
+}
+
+provider "dolt" {
+  path  = "."
+  name  = "John Doe"
+  email = "john.doe@example.com"
+}
+
+data "dolt_query" "records" {
+  database = "dns"
+  as_of    = "v1.0.0"
+
+  query      = "SELECT name, type, value FROM records WHERE zone = ? ORDER BY name"
+  parameters = ["example.com"]
+}
+
+output "records" {
+  value = data.dolt_query.records.rows
+}
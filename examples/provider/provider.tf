@@ -11,3 +11,14 @@ provider "dolt" {
   name  = "John Doe"
   email = "john.doe@example.com"
 }
+
+provider "dolt" {
+  alias = "server"
+
+  server {
+    host     = "localhost"
+    port     = 3306
+    user     = "root"
+    password = "secret"
+  }
+}
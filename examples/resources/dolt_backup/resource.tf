@@ -0,0 +1,28 @@
+terraform {
+  required_providers {
+    dolt = {
+      source = "marcbran/dolt"
+    }
+  }
+}
+
+provider "dolt" {
+  path  = "."
+  name  = "John Doe"
+  email = "john.doe@example.com"
+}
+
+resource "dolt_database" "main" {
+  name = "main"
+}
+
+resource "dolt_backup" "nightly" {
+  database = dolt_database.main.name
+
+  name = "nightly"
+  url  = "file:///mnt/backups/dolt/main"
+
+  triggers = {
+    day = formatdate("YYYY-MM-DD", plantimestamp())
+  }
+}
@@ -0,0 +1,24 @@
+terraform {
+  required_providers {
+    dolt = {
+      source = "marcbran/dolt"
+    }
+  }
+}
+
+provider "dolt" {
+  path  = "."
+  name  = "John Doe"
+  email = "john.doe@example.com"
+}
+
+resource "dolt_database" "main" {
+  name = "main"
+}
+
+resource "dolt_branch" "staging" {
+  database = dolt_database.main.name
+
+  name        = "staging"
+  start_point = "main"
+}
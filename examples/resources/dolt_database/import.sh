@@ -0,0 +1,2 @@
+# Databases are imported by name
+terraform import dolt_database.main main
@@ -15,3 +15,12 @@ provider "dolt" {
 resource "dolt_database" "main" {
   name = "main"
 }
+
+resource "dolt_database" "reference" {
+  name = "reference"
+
+  clone_from {
+    url    = "file:///mnt/shared/dolt/reference"
+    branch = "main"
+  }
+}
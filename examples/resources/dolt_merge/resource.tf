@@ -0,0 +1,61 @@
+terraform {
+  required_providers {
+    dolt = {
+      source = "marcbran/dolt"
+    }
+  }
+}
+
+provider "dolt" {
+  path  = "."
+  name  = "John Doe"
+  email = "john.doe@example.com"
+}
+
+resource "dolt_database" "main" {
+  name = "main"
+}
+
+resource "dolt_table" "articles" {
+  database = dolt_database.main.name
+
+  name  = "articles"
+  query = <<EOF
+CREATE TABLE articles (
+  id INT PRIMARY KEY,
+  title VARCHAR(128)
+);
+EOF
+}
+
+resource "dolt_branch" "staging" {
+  database = dolt_table.articles.database
+
+  name        = "staging"
+  start_point = "main"
+}
+
+resource "dolt_rowset" "staging" {
+  database = dolt_database.main.name
+  branch   = dolt_branch.staging.name
+  table    = dolt_table.articles.name
+
+  columns       = ["id", "title"]
+  unique_column = "id"
+  values = {
+    1 = ["1", "How to use Dolt"],
+  }
+}
+
+resource "dolt_merge" "promote" {
+  database = dolt_database.main.name
+  source   = dolt_rowset.staging.branch
+
+  strategy            = "no-ff"
+  conflict_resolution = "theirs"
+  message             = "Promote staging"
+
+  triggers = {
+    values = jsonencode(dolt_rowset.staging.values)
+  }
+}
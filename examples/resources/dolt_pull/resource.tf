@@ -0,0 +1,36 @@
+terraform {
+  required_providers {
+    dolt = {
+      source = "marcbran/dolt"
+    }
+  }
+}
+
+provider "dolt" {
+  path  = "."
+  name  = "John Doe"
+  email = "john.doe@example.com"
+}
+
+resource "dolt_database" "reference" {
+  name = "reference"
+}
+
+resource "dolt_remote" "origin" {
+  database = dolt_database.reference.name
+
+  name = "origin"
+  url  = "file:///mnt/shared/dolt/reference"
+}
+
+resource "dolt_pull" "main" {
+  database = dolt_remote.origin.database
+
+  remote            = dolt_remote.origin.name
+  ref               = "main"
+  fast_forward_only = true
+
+  triggers = {
+    day = formatdate("YYYY-MM-DD", plantimestamp())
+  }
+}
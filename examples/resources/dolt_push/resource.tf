@@ -0,0 +1,59 @@
+terraform {
+  required_providers {
+    dolt = {
+      source = "marcbran/dolt"
+    }
+  }
+}
+
+provider "dolt" {
+  path  = "."
+  name  = "John Doe"
+  email = "john.doe@example.com"
+}
+
+resource "dolt_database" "main" {
+  name = "main"
+}
+
+resource "dolt_table" "articles" {
+  database = dolt_database.main.name
+
+  name  = "articles"
+  query = <<EOF
+CREATE TABLE articles (
+  id INT PRIMARY KEY,
+  title VARCHAR(128)
+);
+EOF
+}
+
+resource "dolt_rowset" "articles" {
+  database = dolt_database.main.name
+  table    = dolt_table.articles.name
+
+  columns       = ["id", "title"]
+  unique_column = "id"
+  values = {
+    1 = ["1", "How to use Dolt"],
+  }
+}
+
+resource "dolt_remote" "origin" {
+  database = dolt_database.main.name
+
+  name = "origin"
+  url  = "file:///mnt/shared/dolt/main"
+}
+
+resource "dolt_push" "main" {
+  database = dolt_remote.origin.database
+
+  remote       = dolt_remote.origin.name
+  ref          = "main"
+  set_upstream = true
+
+  triggers = {
+    values = jsonencode(dolt_rowset.articles.values)
+  }
+}
@@ -0,0 +1,2 @@
+# Remotes are imported with the format database/remote
+terraform import dolt_remote.origin main/origin
@@ -0,0 +1,24 @@
+terraform {
+  required_providers {
+    dolt = {
+      source = "marcbran/dolt"
+    }
+  }
+}
+
+provider "dolt" {
+  path  = "."
+  name  = "John Doe"
+  email = "john.doe@example.com"
+}
+
+resource "dolt_database" "main" {
+  name = "main"
+}
+
+resource "dolt_remote" "origin" {
+  database = dolt_database.main.name
+
+  name = "origin"
+  url  = "file:///mnt/shared/dolt/main"
+}
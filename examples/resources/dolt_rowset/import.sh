@@ -0,0 +1,5 @@
+# Row sets are imported with the format database/table/unique_column
+terraform import dolt_rowset.rowset main/articles/id
+
+# Composite unique columns are separated by commas
+terraform import dolt_rowset.translations main/translations/article_id,language
@@ -39,3 +39,61 @@ resource "dolt_rowset" "rowset" {
     2 = ["2", "Terraform Internals"],
   }
 }
+
+resource "dolt_table" "authors" {
+  database = dolt_database.main.name
+
+  name  = "authors"
+  query = <<EOF
+CREATE TABLE authors (
+  id INT PRIMARY KEY,
+  name VARCHAR(128),
+  mentor_id INT NULL,
+  profile JSON
+);
+EOF
+}
+
+resource "dolt_rowset" "authors" {
+  database = dolt_database.main.name
+  table    = dolt_table.authors.name
+
+  unique_column = "id"
+  rows = {
+    1 = {
+      name      = "Jane Doe"
+      mentor_id = null
+      profile   = { languages = ["en", "de"] }
+    }
+    2 = {
+      name      = "John Doe"
+      mentor_id = 1
+      profile   = { languages = ["en"] }
+    }
+  }
+}
+
+resource "dolt_table" "translations" {
+  database = dolt_database.main.name
+
+  name  = "translations"
+  query = <<EOF
+CREATE TABLE translations (
+  article_id INT,
+  language VARCHAR(2),
+  title VARCHAR(128),
+  PRIMARY KEY (article_id, language)
+);
+EOF
+}
+
+resource "dolt_rowset" "translations" {
+  database = dolt_database.main.name
+  table    = dolt_table.translations.name
+
+  unique_columns = ["article_id", "language"]
+  rows = {
+    (jsonencode([1, "de"])) = { title = "Wie man Dolt benutzt" }
+    (jsonencode([1, "fr"])) = { title = "Comment utiliser Dolt" }
+  }
+}
@@ -0,0 +1,2 @@
+# Tables are imported with the format database/table
+terraform import dolt_table.articles main/articles
@@ -27,3 +27,48 @@ CREATE TABLE articles (
 );
 EOF
 }
+
+resource "dolt_table" "comments" {
+  database = dolt_database.main.name
+
+  name = "comments"
+
+  column {
+    name           = "id"
+    type           = "INT"
+    nullable       = false
+    auto_increment = true
+  }
+  column {
+    name     = "article_id"
+    type     = "INT"
+    nullable = false
+  }
+  column {
+    name    = "body"
+    type    = "TEXT"
+    comment = "Markdown formatted comment"
+  }
+  column {
+    name    = "created_at"
+    type    = "DATETIME"
+    default = "(CURRENT_TIMESTAMP)"
+  }
+
+  primary_key {
+    columns = ["id"]
+  }
+
+  index {
+    name    = "idx_created_at"
+    columns = ["created_at"]
+  }
+
+  foreign_key {
+    name               = "fk_article"
+    columns            = ["article_id"]
+    referenced_table   = dolt_table.articles.name
+    referenced_columns = ["id"]
+    on_delete          = "CASCADE"
+  }
+}
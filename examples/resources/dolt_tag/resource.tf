@@ -0,0 +1,25 @@
+terraform {
+  required_providers {
+    dolt = {
+      source = "marcbran/dolt"
+    }
+  }
+}
+
+provider "dolt" {
+  path  = "."
+  name  = "John Doe"
+  email = "john.doe@example.com"
+}
+
+resource "dolt_database" "main" {
+  name = "main"
+}
+
+resource "dolt_tag" "release" {
+  database = dolt_database.main.name
+
+  name    = "v1.0.0"
+  ref     = "main"
+  message = "First release of the reference data"
+}
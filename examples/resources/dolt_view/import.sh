@@ -0,0 +1,2 @@
+# Views are imported with the format database/view
+terraform import dolt_view.titles main/titles
@@ -0,0 +1,239 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &BackupResource{}
+
+func NewBackupResource() resource.Resource {
+	return &BackupResource{}
+}
+
+type BackupResource struct {
+	client *DoltClient
+}
+
+type BackupResourceModel struct {
+	Database types.String `tfsdk:"database"`
+	Name     types.String `tfsdk:"name"`
+	Url      types.String `tfsdk:"url"`
+	Triggers types.Map    `tfsdk:"triggers"`
+}
+
+func (m BackupResourceModel) useQuery() string {
+	return fmt.Sprintf("USE %s", quoteIdentifier(m.Database.ValueString()))
+}
+
+func (m BackupResourceModel) commitMessage(operation string) commitMessage {
+	return commitMessage{
+		Type:      "dolt_backup",
+		Database:  m.Database.ValueString(),
+		Address:   m.Name.ValueString(),
+		Operation: operation,
+	}
+}
+
+func (m BackupResourceModel) createQuery() (string, []any) {
+	return "CALL DOLT_BACKUP('add', ?, ?)", []any{m.Name.ValueString(), m.Url.ValueString()}
+}
+
+func (m BackupResourceModel) syncQuery() (string, []any) {
+	return "CALL DOLT_BACKUP('sync', ?)", []any{m.Name.ValueString()}
+}
+
+func (m BackupResourceModel) deleteQuery() (string, []any) {
+	return "CALL DOLT_BACKUP('remove', ?)", []any{m.Name.ValueString()}
+}
+
+func (r *BackupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_backup"
+}
+
+func (r *BackupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Backup resource, configures a backup of a database and syncs all branches, tags and working sets to it. " +
+			"The backup is synced when it is created and whenever the triggers change, destroying the resource removes the backup from the database but leaves the backed up data untouched.",
+
+		Attributes: map[string]schema.Attribute{
+			"database": schema.StringAttribute{
+				MarkdownDescription: "Name of the database to back up",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the backup",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "URL of the backup, e.g. `file:///var/lib/dolt/backups/db` for a directory on the local file system",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values that cause the backup to be synced again when changed",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *BackupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*DoltClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *DoltClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *BackupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data BackupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tx, err := r.client.beginTx(ctx, data.commitMessage("create"))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create backup, got error: %s", err))
+		return
+	}
+
+	_, err = tx.ExecContext(ctx, data.useQuery())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create backup, got error: %s", err))
+		return
+	}
+
+	createQuery, args := data.createQuery()
+	_, err = tx.ExecContext(ctx, createQuery, args...)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create backup, got error: %s", err))
+		return
+	}
+
+	syncQuery, args := data.syncQuery()
+	_, err = tx.ExecContext(ctx, syncQuery, args...)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to sync backup, got error: %s", err))
+		return
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create backup, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created a backup")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read keeps the state as it is, Dolt doesn't support listing backups in SQL.
+func (r *BackupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data BackupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BackupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data BackupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tx, err := r.client.beginTx(ctx, data.commitMessage("update"))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to sync backup, got error: %s", err))
+		return
+	}
+
+	_, err = tx.ExecContext(ctx, data.useQuery())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to sync backup, got error: %s", err))
+		return
+	}
+
+	syncQuery, args := data.syncQuery()
+	_, err = tx.ExecContext(ctx, syncQuery, args...)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to sync backup, got error: %s", err))
+		return
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to sync backup, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "synced a backup")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BackupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data BackupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tx, err := r.client.beginTx(ctx, data.commitMessage("delete"))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete backup, got error: %s", err))
+		return
+	}
+
+	_, err = tx.ExecContext(ctx, data.useQuery())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete backup, got error: %s", err))
+		return
+	}
+
+	deleteQuery, args := data.deleteQuery()
+	_, err = tx.ExecContext(ctx, deleteQuery, args...)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete backup, got error: %s", err))
+		return
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete backup, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a backup")
+}
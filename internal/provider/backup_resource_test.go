@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccBackupResource(t *testing.T) {
+	backupURL := "file://" + t.TempDir()
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() +
+					testAccDatabaseResourceConfig() +
+					testAccTableResourceConfig() +
+					testAccRowSetResourceConfigOne() +
+					testAccBackupResourceConfig(backupURL, "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dolt_backup.test", "name", "nightly"),
+					resource.TestCheckResourceAttr("dolt_backup.test", "url", backupURL),
+					resource.TestCheckResourceAttr("data.dolt_query.backup", "rows.0.row_count", "1"),
+				),
+			},
+			{
+				Config: testAccProviderConfig() +
+					testAccDatabaseResourceConfig() +
+					testAccTableResourceConfig() +
+					testAccRowSetResourceConfigTwo() +
+					testAccBackupResourceConfig(backupURL, "2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("dolt_backup.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dolt_backup.test", "triggers.row_count", "2"),
+					resource.TestCheckResourceAttr("data.dolt_query.backup", "rows.0.row_count", "2"),
+				),
+			},
+		},
+	})
+}
+
+// testAccBackupResourceConfig checks the contents of the backup by cloning it into a new database whenever the triggers change.
+func testAccBackupResourceConfig(url, rowCount string) string {
+	return fmt.Sprintf(`
+resource "dolt_backup" "test" {
+  database = dolt_rowset.test.database
+
+  name = "nightly"
+  url  = "%s"
+
+  triggers = {
+    row_count = "%s"
+  }
+}
+
+resource "dolt_database" "backup" {
+  name = "backup_${dolt_backup.test.triggers.row_count}"
+
+  clone_from {
+    url = dolt_backup.test.url
+  }
+}
+
+data "dolt_query" "backup" {
+  database = dolt_database.backup.name
+  query    = "SELECT COUNT(*) AS row_count FROM test_table"
+}
+`, url, rowCount)
+}
@@ -0,0 +1,251 @@
+package provider
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &BranchResource{}
+var _ resource.ResourceWithImportState = &BranchResource{}
+
+func NewBranchResource() resource.Resource {
+	return &BranchResource{}
+}
+
+type BranchResource struct {
+	client *DoltClient
+}
+
+type BranchResourceModel struct {
+	Database   types.String `tfsdk:"database"`
+	Name       types.String `tfsdk:"name"`
+	StartPoint types.String `tfsdk:"start_point"`
+	Head       types.String `tfsdk:"head"`
+}
+
+func (m BranchResourceModel) useQuery() string {
+	return fmt.Sprintf("USE %s", quoteIdentifier(m.Database.ValueString()))
+}
+
+func (m BranchResourceModel) commitMessage(operation string) commitMessage {
+	return commitMessage{
+		Type:      "dolt_branch",
+		Database:  m.Database.ValueString(),
+		Address:   m.Name.ValueString(),
+		Operation: operation,
+	}
+}
+
+func (m BranchResourceModel) createQuery() (string, []any) {
+	if m.StartPoint.IsNull() || m.StartPoint.ValueString() == "" {
+		return "CALL DOLT_BRANCH(?)", []any{m.Name.ValueString()}
+	}
+	return "CALL DOLT_BRANCH(?, ?)", []any{m.Name.ValueString(), m.StartPoint.ValueString()}
+}
+
+func (m BranchResourceModel) readQuery() (string, []any) {
+	return fmt.Sprintf("SELECT hash FROM %s WHERE name = ?", qualifiedName(m.Database.ValueString(), "dolt_branches")), []any{m.Name.ValueString()}
+}
+
+func (m BranchResourceModel) deleteQuery() (string, []any) {
+	return "CALL DOLT_BRANCH('-d', '-f', ?)", []any{m.Name.ValueString()}
+}
+
+func (r *BranchResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_branch"
+}
+
+func (r *BranchResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Branch resource",
+
+		Attributes: map[string]schema.Attribute{
+			"database": schema.StringAttribute{
+				MarkdownDescription: "Name of the database that contains the branch",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the branch",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"start_point": schema.StringAttribute{
+				MarkdownDescription: "Branch, tag or commit hash the branch is created from, defaults to the head of the current branch",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"head": schema.StringAttribute{
+				MarkdownDescription: "Hash of the commit the branch is currently pointing to",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *BranchResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*DoltClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *DoltClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *BranchResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data BranchResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tx, err := r.client.beginTx(ctx, data.commitMessage("create"))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create branch, got error: %s", err))
+		return
+	}
+
+	_, err = tx.ExecContext(ctx, data.useQuery())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create branch, got error: %s", err))
+		return
+	}
+
+	createQuery, args := data.createQuery()
+	_, err = tx.ExecContext(ctx, createQuery, args...)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create branch, got error: %s", err))
+		return
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create branch, got error: %s", err))
+		return
+	}
+
+	var head string
+	readQuery, args := data.readQuery()
+	err = r.client.db.QueryRowContext(ctx, readQuery, args...).Scan(&head)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read branch, got error: %s", err))
+		return
+	}
+	data.Head = types.StringValue(head)
+
+	tflog.Trace(ctx, "created a branch")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BranchResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data BranchResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var head string
+	readQuery, args := data.readQuery()
+	err := r.client.db.QueryRowContext(ctx, readQuery, args...).Scan(&head)
+	if errors.Is(err, sql.ErrNoRows) {
+		tflog.Warn(ctx, fmt.Sprintf("Branch %s no longer exists, removing it from state", data.Name.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read branch, got error: %s", err))
+		return
+	}
+	data.Head = types.StringValue(head)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BranchResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data BranchResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BranchResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data BranchResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tx, err := r.client.beginTx(ctx, data.commitMessage("delete"))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete branch, got error: %s", err))
+		return
+	}
+
+	_, err = tx.ExecContext(ctx, data.useQuery())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete branch, got error: %s", err))
+		return
+	}
+
+	deleteQuery, args := data.deleteQuery()
+	_, err = tx.ExecContext(ctx, deleteQuery, args...)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete branch, got error: %s", err))
+		return
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete branch, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a branch")
+}
+
+func (r *BranchResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	database, name, ok := strings.Cut(req.ID, "/")
+	if !ok || database == "" || name == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: database/branch. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("database"), database)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+}
@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccBranchResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() +
+					testAccDatabaseResourceConfig() +
+					testAccBranchResourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dolt_branch.test", "name", "test_branch"),
+					resource.TestCheckResourceAttrSet("dolt_branch.test", "head"),
+				),
+			},
+			{
+				ResourceName:                         "dolt_branch.test",
+				ImportState:                          true,
+				ImportStateId:                        "test/test_branch",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+				ImportStateVerifyIgnore:              []string{"start_point"},
+			},
+		},
+	})
+}
+
+func testAccBranchResourceConfig() string {
+	return `
+resource "dolt_branch" "test" {
+  database = dolt_database.test.name
+
+  name        = "test_branch"
+  start_point = "main"
+}
+`
+}
@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"text/template"
+)
+
+const (
+	commitModePerTransaction = "per_transaction"
+	commitModeNone           = "none"
+)
+
+const defaultCommitMessageTemplate = "terraform: {{.Operation}} {{.Type}} {{.Address}}"
+
+// DoltClient is handed to resources and data sources as provider data.
+type DoltClient struct {
+	db                    *sql.DB
+	server                bool
+	commitMode            string
+	commitMessageTemplate *template.Template
+}
+
+// commitMessage holds the values available to the commit message template.
+type commitMessage struct {
+	// Type is the resource type, e.g. dolt_rowset.
+	Type string
+	// Database is the name of the database the resource lives in.
+	Database string
+	// Address identifies the object managed by the resource within its database, e.g. the table of a dolt_rowset.
+	Address string
+	// Operation is one of create, update or delete.
+	Operation string
+}
+
+func newDoltClient(db *sql.DB, server bool, commitMode, commitMessageTemplate string) (*DoltClient, error) {
+	tmpl, err := template.New("commit_message").Option("missingkey=error").Parse(commitMessageTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse commit message template: %w", err)
+	}
+	return &DoltClient{
+		db:                    db,
+		server:                server,
+		commitMode:            commitMode,
+		commitMessageTemplate: tmpl,
+	}, nil
+}
+
+// beginTx starts a transaction that is committed to Dolt according to the configured commit mode.
+// Session variables are set for every transaction because each pooled connection has its own session.
+func (c *DoltClient) beginTx(ctx context.Context, message commitMessage) (*sql.Tx, error) {
+	tx, err := c.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.commitMode == commitModeNone {
+		_, err = tx.ExecContext(ctx, "SET @@dolt_transaction_commit = 0")
+		if err != nil {
+			_ = tx.Rollback()
+			return nil, fmt.Errorf("cannot disable transaction commits: %w", err)
+		}
+		return tx, nil
+	}
+
+	var text strings.Builder
+	err = c.commitMessageTemplate.Execute(&text, message)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("cannot render commit message: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, "SET @@dolt_transaction_commit = 1")
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("cannot enable transaction commits: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, "SET @@dolt_transaction_commit_message = ?", text.String())
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("cannot set transaction commit message: %w", err)
+	}
+
+	return tx, nil
+}
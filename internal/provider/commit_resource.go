@@ -0,0 +1,208 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &CommitResource{}
+
+func NewCommitResource() resource.Resource {
+	return &CommitResource{}
+}
+
+type CommitResource struct {
+	client *DoltClient
+}
+
+type CommitResourceModel struct {
+	Database   types.String `tfsdk:"database"`
+	Branch     types.String `tfsdk:"branch"`
+	Message    types.String `tfsdk:"message"`
+	Author     types.String `tfsdk:"author"`
+	AllowEmpty types.Bool   `tfsdk:"allow_empty"`
+	Triggers   types.Map    `tfsdk:"triggers"`
+	Hash       types.String `tfsdk:"hash"`
+}
+
+func (m CommitResourceModel) useQuery() string {
+	return fmt.Sprintf("USE %s", quoteIdentifier(revisionDatabase(m.Database, m.Branch)))
+}
+
+func (m CommitResourceModel) commitMessage(operation string) commitMessage {
+	return commitMessage{
+		Type:      "dolt_commit",
+		Database:  m.Database.ValueString(),
+		Address:   revisionDatabase(m.Database, m.Branch),
+		Operation: operation,
+	}
+}
+
+func (m CommitResourceModel) commitQuery() (string, []any) {
+	args := []any{"-A", "-m", m.Message.ValueString()}
+	if !m.Author.IsNull() {
+		args = append(args, "--author", m.Author.ValueString())
+	}
+	if m.AllowEmpty.ValueBool() {
+		args = append(args, "--allow-empty")
+	}
+	return fmt.Sprintf("CALL DOLT_COMMIT(%s)", placeholders(len(args))), args
+}
+
+func (r *CommitResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_commit"
+}
+
+func (r *CommitResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Commit resource, stages all changes in the working set and commits them. " +
+			"A new commit is created whenever any of the arguments change, destroying the resource leaves the commit history untouched.",
+
+		Attributes: map[string]schema.Attribute{
+			"database": schema.StringAttribute{
+				MarkdownDescription: "Name of the database to commit",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"branch": schema.StringAttribute{
+				MarkdownDescription: "Branch to commit to, defaults to the default branch",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"message": schema.StringAttribute{
+				MarkdownDescription: "Commit message",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"author": schema.StringAttribute{
+				MarkdownDescription: "Author of the commit in the format `Name <email>`, defaults to the committer configured in the provider",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"allow_empty": schema.BoolAttribute{
+				MarkdownDescription: "Whether to create the commit even if there are no changes to commit",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values that cause a new commit to be created when changed",
+				ElementType:         types.StringType,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"hash": schema.StringAttribute{
+				MarkdownDescription: "Hash of the created commit",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *CommitResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*DoltClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *DoltClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CommitResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CommitResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tx, err := r.client.beginTx(ctx, data.commitMessage("create"))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create commit, got error: %s", err))
+		return
+	}
+
+	_, err = tx.ExecContext(ctx, data.useQuery())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create commit, got error: %s", err))
+		return
+	}
+
+	var hash string
+	commitQuery, args := data.commitQuery()
+	err = tx.QueryRowContext(ctx, commitQuery, args...).Scan(&hash)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create commit, got error: %s", err))
+		return
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create commit, got error: %s", err))
+		return
+	}
+
+	data.Hash = types.StringValue(hash)
+
+	tflog.Trace(ctx, "created a commit")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CommitResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CommitResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CommitResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data CommitResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CommitResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Trace(ctx, "removed a commit from state, the commit history is left untouched")
+}
@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCommitResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() +
+					testAccDatabaseResourceConfig() +
+					testAccTableResourceConfig() +
+					testAccRowSetResourceConfigOne() +
+					testAccCommitResourceConfig("1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dolt_commit.test", "message", "Add test rows"),
+					resource.TestCheckResourceAttr("dolt_commit.test", "allow_empty", "true"),
+					resource.TestCheckResourceAttrSet("dolt_commit.test", "hash"),
+				),
+			},
+			{
+				Config: testAccProviderConfig() +
+					testAccDatabaseResourceConfig() +
+					testAccTableResourceConfig() +
+					testAccRowSetResourceConfigTwo() +
+					testAccCommitResourceConfig("2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dolt_commit.test", "triggers.row_count", "2"),
+					resource.TestCheckResourceAttrSet("dolt_commit.test", "hash"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCommitResourceWithoutTransactionCommits(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfigWithCommitMode("none") +
+					testAccDatabaseResourceConfig() +
+					testAccTableResourceConfig() +
+					testAccRowSetResourceConfigOne() +
+					testAccCommitResourceWithoutTransactionCommitsConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dolt_commit.test", "allow_empty", "false"),
+					resource.TestCheckResourceAttrSet("dolt_commit.test", "hash"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCommitResourceConfig(rowCount string) string {
+	return fmt.Sprintf(`
+resource "dolt_commit" "test" {
+  database = dolt_rowset.test.database
+
+  message     = "Add test rows"
+  author      = "Jane Doe <jane.doe@example.com>"
+  allow_empty = true
+
+  triggers = {
+    row_count = "%s"
+  }
+}
+`, rowCount)
+}
+
+func testAccCommitResourceWithoutTransactionCommitsConfig() string {
+	return `
+resource "dolt_commit" "test" {
+  database = dolt_rowset.test.database
+
+  message = "Add test table and rows"
+}
+`
+}
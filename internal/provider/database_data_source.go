@@ -2,7 +2,6 @@ package provider
 
 import (
 	"context"
-	"database/sql"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -17,15 +16,15 @@ func NewDatabaseDataSource() datasource.DataSource {
 }
 
 type DatabaseDataSource struct {
-	db *sql.DB
+	client *DoltClient
 }
 
 type DatabaseDataSourceModel struct {
 	Name types.String `tfsdk:"name"`
 }
 
-func (m DatabaseDataSourceModel) readQuery() string {
-	return fmt.Sprintf("SHOW DATABASES LIKE '%s'", m.Name.ValueString())
+func (m DatabaseDataSourceModel) readQuery() (string, []any) {
+	return "SELECT SCHEMA_NAME FROM INFORMATION_SCHEMA.SCHEMATA WHERE SCHEMA_NAME = ?", []any{m.Name.ValueString()}
 }
 
 func (d *DatabaseDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -50,18 +49,18 @@ func (d *DatabaseDataSource) Configure(ctx context.Context, req datasource.Confi
 		return
 	}
 
-	db, ok := req.ProviderData.(*sql.DB)
+	client, ok := req.ProviderData.(*DoltClient)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *sql.DB, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *DoltClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	d.db = db
+	d.client = client
 }
 
 func (d *DatabaseDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -71,7 +70,8 @@ func (d *DatabaseDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 
-	result, err := d.db.QueryContext(ctx, data.readQuery())
+	readQuery, args := data.readQuery()
+	result, err := d.client.db.QueryContext(ctx, readQuery, args...)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read database, got error: %s", err))
 		return
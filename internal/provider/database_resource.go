@@ -3,41 +3,87 @@ package provider
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var _ resource.Resource = &DatabaseResource{}
 var _ resource.ResourceWithImportState = &DatabaseResource{}
+var _ resource.ResourceWithConfigValidators = &DatabaseResource{}
+var _ resource.ResourceWithModifyPlan = &DatabaseResource{}
 
 func NewDatabaseResource() resource.Resource {
 	return &DatabaseResource{}
 }
 
 type DatabaseResource struct {
-	db *sql.DB
+	client *DoltClient
 }
 
 type DatabaseResourceModel struct {
-	Name types.String `tfsdk:"name"`
+	Id                types.String            `tfsdk:"id"`
+	Name              types.String            `tfsdk:"name"`
+	CloneFrom         *DatabaseCloneFromModel `tfsdk:"clone_from"`
+	RestoreFromBackup types.String            `tfsdk:"restore_from_backup"`
 }
 
-func (m DatabaseResourceModel) createQuery() string {
-	return fmt.Sprintf("CREATE DATABASE %s", m.Name.ValueString())
+type DatabaseCloneFromModel struct {
+	Url    types.String `tfsdk:"url"`
+	Branch types.String `tfsdk:"branch"`
+	Depth  types.Int64  `tfsdk:"depth"`
 }
 
-func (m DatabaseResourceModel) readQuery() string {
-	return fmt.Sprintf("SHOW DATABASES LIKE '%s'", m.Name.ValueString())
+// cloneRemote is the name of the remote a cloned database is cloned from.
+const cloneRemote = "origin"
+
+func (m DatabaseResourceModel) id() string {
+	return m.Name.ValueString()
+}
+
+func (m DatabaseResourceModel) createQuery() (string, []any) {
+	if m.CloneFrom == nil {
+		return fmt.Sprintf("CREATE DATABASE %s", quoteIdentifier(m.Name.ValueString())), nil
+	}
+	args := []any{"--remote", cloneRemote}
+	if !m.CloneFrom.Branch.IsNull() {
+		args = append(args, "--branch", m.CloneFrom.Branch.ValueString())
+	}
+	if !m.CloneFrom.Depth.IsNull() {
+		args = append(args, "--depth", fmt.Sprintf("%d", m.CloneFrom.Depth.ValueInt64()))
+	}
+	args = append(args, m.CloneFrom.Url.ValueString(), m.Name.ValueString())
+	return fmt.Sprintf("CALL DOLT_CLONE(%s)", placeholders(len(args))), args
+}
+
+func (m DatabaseResourceModel) useQuery() string {
+	return fmt.Sprintf("USE %s", quoteIdentifier(m.Name.ValueString()))
+}
+
+// restoreQuery restores the backup into the database created by createQuery. Restoring into a new database
+// only writes it to disk without loading it, so the backup replaces the empty database instead.
+func (m DatabaseResourceModel) restoreQuery() (string, []any) {
+	return "CALL DOLT_BACKUP('restore', '--force', ?, ?)", []any{m.RestoreFromBackup.ValueString(), m.Name.ValueString()}
+}
+
+func (m DatabaseResourceModel) readQuery() (string, []any) {
+	return "SELECT SCHEMA_NAME FROM INFORMATION_SCHEMA.SCHEMATA WHERE SCHEMA_NAME = ?", []any{m.Name.ValueString()}
 }
 
 func (m DatabaseResourceModel) deleteQuery() string {
-	return fmt.Sprintf("DROP DATABASE %s", m.Name.ValueString())
+	return fmt.Sprintf("DROP DATABASE %s", quoteIdentifier(m.Name.ValueString()))
 }
 
 func (r *DatabaseResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -49,6 +95,13 @@ func (r *DatabaseResource) Schema(ctx context.Context, req resource.SchemaReques
 		MarkdownDescription: "Database resource",
 
 		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Database identifier, same as the name",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "Database name",
 				Required:            true,
@@ -56,27 +109,99 @@ func (r *DatabaseResource) Schema(ctx context.Context, req resource.SchemaReques
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"restore_from_backup": schema.StringAttribute{
+				MarkdownDescription: "URL of a backup to restore the database from instead of creating an empty database, conflicts with `clone_from`. " +
+					"Restoring requires the `SUPER` privilege, which is only checked when connecting to a server, so it is rejected with `path`",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"clone_from": schema.SingleNestedBlock{
+				MarkdownDescription: "Remote to clone the database from instead of creating an empty database, it is configured as remote `origin` of the database",
+				Validators: []validator.Object{
+					objectvalidator.AlsoRequires(path.MatchRelative().AtName("url")),
+				},
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"url": schema.StringAttribute{
+						MarkdownDescription: "URL of the remote, e.g. `file:///var/lib/dolt/remotes/db` for a directory on the local file system",
+						Optional:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+					"branch": schema.StringAttribute{
+						MarkdownDescription: "Branch to clone, defaults to all branches with the default branch of the remote checked out",
+						Optional:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+					"depth": schema.Int64Attribute{
+						MarkdownDescription: "Number of commits of the history to clone, defaults to the full history",
+						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.RequiresReplace(),
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
+func (r *DatabaseResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.Conflicting(path.MatchRoot("clone_from"), path.MatchRoot("restore_from_backup")),
+	}
+}
+
 func (r *DatabaseResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
-	db, ok := req.ProviderData.(*sql.DB)
+	client, ok := req.ProviderData.(*DoltClient)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *sql.DB, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *DoltClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.db = db
+	r.client = client
+}
+
+// ModifyPlan rejects restoring a backup with an embedded client, which cannot pass the privilege check of DOLT_BACKUP.
+// The client is only known once the provider is configured, so this cannot be checked in ConfigValidators.
+func (r *DatabaseResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil || r.client.server {
+		return
+	}
+
+	var restoreFromBackup types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("restore_from_backup"), &restoreFromBackup)...)
+	if resp.Diagnostics.HasError() || restoreFromBackup.IsNull() {
+		return
+	}
+	if !req.State.Raw.IsNull() && len(resp.RequiresReplace) == 0 {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(path.Root("restore_from_backup"), "Restore Not Supported",
+		"Restoring a backup requires the SUPER privilege, which cannot be checked when the provider uses path. Configure the provider with server to restore backups")
 }
 
 func (r *DatabaseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -86,16 +211,48 @@ func (r *DatabaseResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	_, err := r.db.ExecContext(ctx, data.createQuery())
+	createQuery, args := data.createQuery()
+	_, err := r.client.db.ExecContext(ctx, createQuery, args...)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create database, got error: %s", err))
 		return
 	}
 
+	if !data.RestoreFromBackup.IsNull() {
+		err = r.restore(ctx, data)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to restore database, got error: %s", err))
+			_, err = r.client.db.ExecContext(ctx, data.deleteQuery())
+			if err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete database after failed restore, got error: %s", err))
+			}
+			return
+		}
+	}
+
+	data.Id = types.StringValue(data.id())
+
 	tflog.Trace(ctx, "created a resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// restore restores the backup into the database created for it. DOLT_BACKUP needs a database to run in.
+func (r *DatabaseResource) restore(ctx context.Context, data DatabaseResourceModel) error {
+	conn, err := r.client.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	_, err = conn.ExecContext(ctx, data.useQuery())
+	if err != nil {
+		return err
+	}
+	restoreQuery, args := data.restoreQuery()
+	_, err = conn.ExecContext(ctx, restoreQuery, args...)
+	return err
+}
+
 func (r *DatabaseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var data DatabaseResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -103,27 +260,24 @@ func (r *DatabaseResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
-	result, err := r.db.QueryContext(ctx, data.readQuery())
+	var name string
+	readQuery, args := data.readQuery()
+	err := r.client.db.QueryRowContext(ctx, readQuery, args...).Scan(&name)
+	if errors.Is(err, sql.ErrNoRows) {
+		tflog.Warn(ctx, fmt.Sprintf("Database %s no longer exists, removing it from state", data.Name.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read database, got error: %s", err))
 		return
 	}
-	if !result.Next() {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Cannot find database with name %s", data.Name.ValueString()))
+	if name != data.Name.ValueString() {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Expected database name %s to be %s", name, data.Name.ValueString()))
 		return
 	}
-	for result.Next() {
-		var name string
-		err := result.Scan(&name)
-		if err != nil {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read database, got error: %s", err))
-			return
-		}
-		if name != data.Name.ValueString() {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Expected database name %s to be %s", name, data.Name.ValueString()))
-			return
-		}
-	}
+
+	data.Id = types.StringValue(data.id())
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -145,7 +299,7 @@ func (r *DatabaseResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
-	_, err := r.db.ExecContext(ctx, data.deleteQuery())
+	_, err := r.client.db.ExecContext(ctx, data.deleteQuery())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete database, got error: %s", err))
 		return
@@ -154,4 +308,5 @@ func (r *DatabaseResource) Delete(ctx context.Context, req resource.DeleteReques
 
 func (r *DatabaseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
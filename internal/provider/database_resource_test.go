@@ -1,9 +1,12 @@
 package provider
 
 import (
+	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 )
 
 func TestAccDatabaseResource(t *testing.T) {
@@ -14,7 +17,14 @@ func TestAccDatabaseResource(t *testing.T) {
 			{
 				Config: testAccProviderConfig() +
 					testAccDatabaseResourceConfig(),
-				Check: resource.ComposeAggregateTestCheckFunc(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dolt_database.test", "id", "test"),
+				),
+			},
+			{
+				ResourceName:      "dolt_database.test",
+				ImportState:       true,
+				ImportStateVerify: true,
 			},
 		},
 	})
@@ -27,3 +37,193 @@ resource "dolt_database" "test" {
 }
 `
 }
+
+func TestAccDatabaseResourceDropped(t *testing.T) {
+	host, port := testAccDoltServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderServerConfig(host, port) +
+					testAccDatabaseResourceConfig(),
+			},
+			{
+				PreConfig: func() {
+					testAccServerExec(t, host, port, "DROP DATABASE test")
+				},
+				Config: testAccProviderServerConfig(host, port) +
+					testAccDatabaseResourceConfig(),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("dolt_database.test", plancheck.ResourceActionCreate),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccDatabaseResourceClone(t *testing.T) {
+	remoteURL := "file://" + t.TempDir()
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() +
+					testAccDatabaseResourceConfig() +
+					testAccTableResourceConfig() +
+					testAccRowSetResourceConfigTwo() +
+					testAccRemoteResourceConfig(remoteURL) +
+					testAccPushResourceConfig("2") +
+					testAccDatabaseResourceCloneConfig(remoteURL),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dolt_database.clone", "id", "clone"),
+					resource.TestCheckResourceAttr("dolt_database.clone", "clone_from.branch", "main"),
+					resource.TestCheckResourceAttr("data.dolt_query.clone", "rows.#", "1"),
+					resource.TestCheckResourceAttr("data.dolt_query.clone", "rows.0.name", "origin"),
+					resource.TestCheckResourceAttr("data.dolt_query.clone", "rows.0.url", remoteURL),
+					resource.TestCheckResourceAttr("data.dolt_query.clone", "rows.0.row_count", "2"),
+					resource.TestCheckResourceAttrPair("data.dolt_query.clone", "rows.0.head", "dolt_push.test", "hash"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDatabaseResourceCloneConfig(url string) string {
+	return fmt.Sprintf(`
+resource "dolt_database" "clone" {
+  name = "clone"
+
+  clone_from {
+    url    = "%s"
+    branch = "main"
+    depth  = 1
+  }
+
+  depends_on = [dolt_push.test]
+}
+
+data "dolt_query" "clone" {
+  database = dolt_database.clone.name
+  query    = <<EOF
+SELECT name, url, HASHOF('HEAD') AS head, (SELECT COUNT(*) FROM test_table) AS row_count
+FROM dolt_remotes
+EOF
+}
+`, url)
+}
+
+func TestAccDatabaseResourceRestore(t *testing.T) {
+	host, port := testAccDoltServer(t)
+	backupURL := "file://" + t.TempDir()
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderServerConfig(host, port) +
+					testAccDatabaseResourceConfig() +
+					testAccTableResourceConfig() +
+					testAccRowSetResourceConfigTwo() +
+					testAccDatabaseResourceRestoreConfig(backupURL),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dolt_database.restored", "id", "restored"),
+					resource.TestCheckResourceAttr("data.dolt_query.restored", "rows.#", "2"),
+					resource.TestCheckResourceAttr("data.dolt_query.restored", "rows.0.name", "Alice"),
+					resource.TestCheckResourceAttr("data.dolt_query.restored", "rows.1.name", "Bob"),
+				),
+			},
+			{
+				Config: testAccProviderServerConfig(host, port) +
+					testAccDatabaseResourceConfig() +
+					testAccTableResourceConfig() +
+					testAccRowSetResourceConfigTwo() +
+					testAccDatabaseResourceRestoreConfig(backupURL) + `
+resource "dolt_database" "missing" {
+  name                = "missing"
+  restore_from_backup = "file:///nonexistent/backup"
+}
+`,
+				ExpectError: regexp.MustCompile("Unable to restore database"),
+			},
+			{
+				Config: testAccProviderServerConfig(host, port) +
+					testAccDatabaseResourceConfig() +
+					testAccTableResourceConfig() +
+					testAccRowSetResourceConfigTwo() +
+					testAccDatabaseResourceRestoreConfig(backupURL) + `
+data "dolt_query" "missing" {
+  database = dolt_database.restored.name
+  query    = "SELECT SCHEMA_NAME FROM INFORMATION_SCHEMA.SCHEMATA WHERE SCHEMA_NAME = 'missing'"
+}
+`,
+				Check: resource.TestCheckResourceAttr("data.dolt_query.missing", "rows.#", "0"),
+			},
+		},
+	})
+}
+
+func testAccDatabaseResourceRestoreConfig(url string) string {
+	return fmt.Sprintf(`
+resource "dolt_backup" "test" {
+  database = dolt_rowset.test.database
+
+  name = "nightly"
+  url  = "%s"
+}
+
+resource "dolt_database" "restored" {
+  name                = "restored"
+  restore_from_backup = dolt_backup.test.url
+}
+
+data "dolt_query" "restored" {
+  database = dolt_database.restored.name
+  query    = "SELECT id, name FROM test_table ORDER BY id"
+}
+`, url)
+}
+
+func TestAccDatabaseResourceRestoreConflictsWithClone(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + `
+resource "dolt_database" "test" {
+  name                = "test"
+  restore_from_backup = "file:///tmp/backups/test"
+
+  clone_from {
+    url = "file:///tmp/remotes/test"
+  }
+}
+`,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+		},
+	})
+}
+
+func TestAccDatabaseResourceRestoreWithPath(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + `
+resource "dolt_database" "test" {
+  name                = "test"
+  restore_from_backup = "file:///tmp/backups/test"
+}
+`,
+				ExpectError: regexp.MustCompile("Restore Not Supported"),
+			},
+		},
+	})
+}
@@ -0,0 +1,388 @@
+package provider
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"strings"
+)
+
+var _ datasource.DataSource = &DiffDataSource{}
+
+func NewDiffDataSource() datasource.DataSource {
+	return &DiffDataSource{}
+}
+
+type DiffDataSource struct {
+	client *DoltClient
+}
+
+type DiffDataSourceModel struct {
+	Database types.String `tfsdk:"database"`
+	From     types.String `tfsdk:"from"`
+	To       types.String `tfsdk:"to"`
+	Table    types.String `tfsdk:"table"`
+	Rows     types.List   `tfsdk:"rows"`
+	Summary  types.List   `tfsdk:"summary"`
+}
+
+var diffRowType = basetypes.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"diff_type": types.StringType,
+		"from":      types.MapType{ElemType: types.StringType},
+		"to":        types.MapType{ElemType: types.StringType},
+	},
+}
+
+var diffSummaryType = basetypes.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"table":          types.StringType,
+		"from_table":     types.StringType,
+		"to_table":       types.StringType,
+		"diff_type":      types.StringType,
+		"data_change":    types.BoolType,
+		"schema_change":  types.BoolType,
+		"rows_added":     types.Int64Type,
+		"rows_deleted":   types.Int64Type,
+		"rows_modified":  types.Int64Type,
+		"cells_added":    types.Int64Type,
+		"cells_deleted":  types.Int64Type,
+		"cells_modified": types.Int64Type,
+	},
+}
+
+// diffMetadataColumns are the columns of DOLT_DIFF that describe the compared commits instead of the row.
+var diffMetadataColumns = map[string]bool{
+	"diff_type":        true,
+	"from_commit":      true,
+	"from_commit_date": true,
+	"to_commit":        true,
+	"to_commit_date":   true,
+}
+
+func (m DiffDataSourceModel) useQuery() string {
+	return fmt.Sprintf("USE %s", quoteIdentifier(m.Database.ValueString()))
+}
+
+// functionArgs returns the arguments of the diff table functions. They cannot be bound as placeholders,
+// so they are quoted as string literals.
+func (m DiffDataSourceModel) functionArgs() string {
+	args := []string{quoteString(m.From.ValueString()), quoteString(m.To.ValueString())}
+	if !m.Table.IsNull() {
+		args = append(args, quoteString(m.Table.ValueString()))
+	}
+	return strings.Join(args, ", ")
+}
+
+func (m DiffDataSourceModel) rowsQuery() string {
+	return fmt.Sprintf("SELECT * FROM DOLT_DIFF(%s)", m.functionArgs())
+}
+
+func (m DiffDataSourceModel) summaryQuery() string {
+	return fmt.Sprintf("SELECT from_table_name, to_table_name, diff_type, data_change, schema_change FROM DOLT_DIFF_SUMMARY(%s)", m.functionArgs())
+}
+
+func (m DiffDataSourceModel) statQuery() string {
+	return fmt.Sprintf(`
+		SELECT table_name, rows_added, rows_deleted, rows_modified, cells_added, cells_deleted, cells_modified
+		FROM DOLT_DIFF_STAT(%s)`, m.functionArgs())
+}
+
+func (d *DiffDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_diff"
+}
+
+func (d *DiffDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Diff data source, compares the data of two revisions of a database",
+
+		Attributes: map[string]schema.Attribute{
+			"database": schema.StringAttribute{
+				MarkdownDescription: "Name of the database",
+				Required:            true,
+			},
+			"from": schema.StringAttribute{
+				MarkdownDescription: "Branch, tag, commit hash or expression such as `HEAD~1` to compare from",
+				Required:            true,
+			},
+			"to": schema.StringAttribute{
+				MarkdownDescription: "Branch, tag, commit hash or expression such as `HEAD` to compare to, `WORKING` for uncommitted changes",
+				Required:            true,
+			},
+			"table": schema.StringAttribute{
+				MarkdownDescription: "Table to compare, `rows` is only read if set. The summary covers all tables otherwise",
+				Optional:            true,
+			},
+			"rows": schema.ListNestedAttribute{
+				MarkdownDescription: "Rows of the table that were added, modified or removed",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"diff_type": schema.StringAttribute{
+							MarkdownDescription: "One of `added`, `modified` or `removed`",
+							Computed:            true,
+						},
+						"from": schema.MapAttribute{
+							MarkdownDescription: "Values of the row in `from` by column name, null for added rows",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+						"to": schema.MapAttribute{
+							MarkdownDescription: "Values of the row in `to` by column name, null for removed rows",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"summary": schema.ListNestedAttribute{
+				MarkdownDescription: "Changes per table",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"table": schema.StringAttribute{
+							MarkdownDescription: "Name of the table in `to`, or in `from` if the table was dropped",
+							Computed:            true,
+						},
+						"from_table": schema.StringAttribute{
+							MarkdownDescription: "Name of the table in `from`, empty if the table was added",
+							Computed:            true,
+						},
+						"to_table": schema.StringAttribute{
+							MarkdownDescription: "Name of the table in `to`, empty if the table was dropped",
+							Computed:            true,
+						},
+						"diff_type": schema.StringAttribute{
+							MarkdownDescription: "One of `added`, `dropped`, `modified` or `renamed`",
+							Computed:            true,
+						},
+						"data_change": schema.BoolAttribute{
+							MarkdownDescription: "Whether rows of the table changed",
+							Computed:            true,
+						},
+						"schema_change": schema.BoolAttribute{
+							MarkdownDescription: "Whether the schema of the table changed",
+							Computed:            true,
+						},
+						"rows_added": schema.Int64Attribute{
+							MarkdownDescription: "Number of added rows",
+							Computed:            true,
+						},
+						"rows_deleted": schema.Int64Attribute{
+							MarkdownDescription: "Number of removed rows",
+							Computed:            true,
+						},
+						"rows_modified": schema.Int64Attribute{
+							MarkdownDescription: "Number of modified rows",
+							Computed:            true,
+						},
+						"cells_added": schema.Int64Attribute{
+							MarkdownDescription: "Number of added cells",
+							Computed:            true,
+						},
+						"cells_deleted": schema.Int64Attribute{
+							MarkdownDescription: "Number of removed cells",
+							Computed:            true,
+						},
+						"cells_modified": schema.Int64Attribute{
+							MarkdownDescription: "Number of modified cells",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *DiffDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*DoltClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *DoltClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *DiffDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DiffDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn, err := d.client.db.Conn(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read diff, got error: %s", err))
+		return
+	}
+	defer conn.Close()
+
+	_, err = conn.ExecContext(ctx, data.useQuery())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read diff, got error: %s", err))
+		return
+	}
+
+	var rows []attr.Value
+	if !data.Table.IsNull() {
+		rows, err = readDiffRows(ctx, conn, data.rowsQuery())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read diff, got error: %s", err))
+			return
+		}
+	}
+
+	summary, err := readDiffSummary(ctx, conn, data.summaryQuery(), data.statQuery())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read diff summary, got error: %s", err))
+		return
+	}
+
+	rowsList, diagnostics := types.ListValue(diffRowType, rows)
+	resp.Diagnostics.Append(diagnostics...)
+	data.Rows = rowsList
+	summaryList, diagnostics := types.ListValue(diffSummaryType, summary)
+	resp.Diagnostics.Append(diagnostics...)
+	data.Summary = summaryList
+
+	tflog.Trace(ctx, "read a data source")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func readDiffRows(ctx context.Context, conn *sql.Conn, query string) ([]attr.Value, error) {
+	result, err := conn.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer result.Close()
+
+	columns, err := result.Columns()
+	if err != nil {
+		return nil, err
+	}
+	var rows []attr.Value
+	for result.Next() {
+		values := make([]any, len(columns))
+		pointers := make([]any, len(columns))
+		for i := range values {
+			pointers[i] = &values[i]
+		}
+		err := result.Scan(pointers...)
+		if err != nil {
+			return nil, err
+		}
+		var diffType string
+		from := map[string]attr.Value{}
+		to := map[string]attr.Value{}
+		for i, column := range columns {
+			if column == "diff_type" {
+				diffType, _ = rowText(values[i], "")
+			}
+			if diffMetadataColumns[column] {
+				continue
+			}
+			value := types.StringNull()
+			if text, ok := rowText(values[i], ""); ok {
+				value = types.StringValue(text)
+			}
+			if name, ok := strings.CutPrefix(column, "from_"); ok {
+				from[name] = value
+			} else if name, ok := strings.CutPrefix(column, "to_"); ok {
+				to[name] = value
+			}
+		}
+		fromValue := types.MapNull(types.StringType)
+		if diffType != "added" {
+			fromValue = types.MapValueMust(types.StringType, from)
+		}
+		toValue := types.MapNull(types.StringType)
+		if diffType != "removed" {
+			toValue = types.MapValueMust(types.StringType, to)
+		}
+		rows = append(rows, types.ObjectValueMust(diffRowType.AttrTypes, map[string]attr.Value{
+			"diff_type": types.StringValue(diffType),
+			"from":      fromValue,
+			"to":        toValue,
+		}))
+	}
+	return rows, result.Err()
+}
+
+func readDiffSummary(ctx context.Context, conn *sql.Conn, summaryQuery, statQuery string) ([]attr.Value, error) {
+	type stat struct {
+		rowsAdded, rowsDeleted, rowsModified    int64
+		cellsAdded, cellsDeleted, cellsModified int64
+	}
+	stats := map[string]stat{}
+	result, err := conn.QueryContext(ctx, statQuery)
+	if err != nil {
+		return nil, err
+	}
+	for result.Next() {
+		var table string
+		var s stat
+		err := result.Scan(&table, &s.rowsAdded, &s.rowsDeleted, &s.rowsModified, &s.cellsAdded, &s.cellsDeleted, &s.cellsModified)
+		if err != nil {
+			result.Close()
+			return nil, err
+		}
+		stats[table] = s
+	}
+	err = result.Err()
+	result.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	result, err = conn.QueryContext(ctx, summaryQuery)
+	if err != nil {
+		return nil, err
+	}
+	defer result.Close()
+	var summary []attr.Value
+	for result.Next() {
+		var fromTable, toTable, diffType string
+		var dataChange, schemaChange bool
+		err := result.Scan(&fromTable, &toTable, &diffType, &dataChange, &schemaChange)
+		if err != nil {
+			return nil, err
+		}
+		table := toTable
+		if table == "" {
+			table = fromTable
+		}
+		s := stats[table]
+		summary = append(summary, types.ObjectValueMust(diffSummaryType.AttrTypes, map[string]attr.Value{
+			"table":          types.StringValue(table),
+			"from_table":     types.StringValue(fromTable),
+			"to_table":       types.StringValue(toTable),
+			"diff_type":      types.StringValue(diffType),
+			"data_change":    types.BoolValue(dataChange),
+			"schema_change":  types.BoolValue(schemaChange),
+			"rows_added":     types.Int64Value(s.rowsAdded),
+			"rows_deleted":   types.Int64Value(s.rowsDeleted),
+			"rows_modified":  types.Int64Value(s.rowsModified),
+			"cells_added":    types.Int64Value(s.cellsAdded),
+			"cells_deleted":  types.Int64Value(s.cellsDeleted),
+			"cells_modified": types.Int64Value(s.cellsModified),
+		}))
+	}
+	return summary, result.Err()
+}
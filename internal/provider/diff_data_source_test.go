@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDiffDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() +
+					testAccDatabaseResourceConfig() +
+					testAccTableResourceConfig() +
+					testAccRowSetResourceConfigTwo(),
+			},
+			{
+				Config: testAccProviderConfig() +
+					testAccDatabaseResourceConfig() +
+					testAccTableResourceConfig() +
+					testAccDiffDataSourceRowSetConfig() +
+					testAccDiffDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.dolt_diff.test", "rows.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.dolt_diff.test", "rows.*", map[string]string{
+						"diff_type": "modified",
+						"from.id":   "1",
+						"from.name": "Alice",
+						"to.id":     "1",
+						"to.name":   "Alicia",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.dolt_diff.test", "rows.*", map[string]string{
+						"diff_type": "removed",
+						"from.name": "Bob",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.dolt_diff.test", "rows.*", map[string]string{
+						"diff_type": "added",
+						"to.name":   "Carol",
+					}),
+					resource.TestCheckResourceAttr("data.dolt_diff.test", "summary.#", "1"),
+					resource.TestCheckResourceAttr("data.dolt_diff.test", "summary.0.table", "test_table"),
+					resource.TestCheckResourceAttr("data.dolt_diff.test", "summary.0.diff_type", "modified"),
+					resource.TestCheckResourceAttr("data.dolt_diff.test", "summary.0.data_change", "true"),
+					resource.TestCheckResourceAttr("data.dolt_diff.test", "summary.0.schema_change", "false"),
+					resource.TestCheckResourceAttr("data.dolt_diff.test", "summary.0.rows_added", "1"),
+					resource.TestCheckResourceAttr("data.dolt_diff.test", "summary.0.rows_deleted", "1"),
+					resource.TestCheckResourceAttr("data.dolt_diff.test", "summary.0.rows_modified", "1"),
+					resource.TestCheckResourceAttr("data.dolt_diff.all", "rows.#", "0"),
+					resource.TestCheckResourceAttr("data.dolt_diff.all", "summary.#", "1"),
+					resource.TestCheckResourceAttr("data.dolt_diff.all", "summary.0.diff_type", "added"),
+				),
+			},
+			{
+				Config: testAccProviderConfig() +
+					testAccDatabaseResourceConfig() +
+					testAccTableResourceConfig() +
+					testAccDiffDataSourceRowSetConfig() +
+					testAccDiffDataSourceQuotedConfig(),
+				ExpectError: regexp.MustCompile("branch not found: it's"),
+			},
+		},
+	})
+}
+
+func testAccDiffDataSourceQuotedConfig() string {
+	return `
+data "dolt_diff" "quoted" {
+  database = dolt_rowset.test.database
+  from     = "it's"
+  to       = "HEAD"
+  table    = dolt_rowset.test.table
+}
+`
+}
+
+func TestDiffDataSourceFunctionArgs(t *testing.T) {
+	model := DiffDataSourceModel{
+		From:  types.StringValue(`it's\`),
+		To:    types.StringValue("HEAD"),
+		Table: types.StringValue(`a'b\`),
+	}
+	expected := `'it''s\\', 'HEAD', 'a''b\\'`
+	if args := model.functionArgs(); args != expected {
+		t.Errorf("expected %s, got %s", expected, args)
+	}
+}
+
+func testAccDiffDataSourceRowSetConfig() string {
+	return `
+resource "dolt_rowset" "test" {
+  database = dolt_database.test.name
+  table    = dolt_table.test.name
+
+  columns       = ["id", "name"]
+  unique_column = "id"
+  values  = {
+    1 = ["1", "Alicia"],
+    3 = ["3", "Carol"],
+  }
+}
+`
+}
+
+func testAccDiffDataSourceConfig() string {
+	return `
+data "dolt_diff" "test" {
+  database = dolt_rowset.test.database
+  from     = "HEAD~1"
+  to       = "HEAD"
+  table    = dolt_rowset.test.table
+}
+
+data "dolt_diff" "all" {
+  database = dolt_rowset.test.database
+  from     = "HEAD~3"
+  to       = "HEAD"
+}
+`
+}
@@ -0,0 +1,201 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &FetchResource{}
+
+func NewFetchResource() resource.Resource {
+	return &FetchResource{}
+}
+
+type FetchResource struct {
+	client *DoltClient
+}
+
+type FetchResourceModel struct {
+	Database types.String `tfsdk:"database"`
+	Remote   types.String `tfsdk:"remote"`
+	Ref      types.String `tfsdk:"ref"`
+	Triggers types.Map    `tfsdk:"triggers"`
+	Hash     types.String `tfsdk:"hash"`
+}
+
+func (m FetchResourceModel) useQuery() string {
+	return fmt.Sprintf("USE %s", quoteIdentifier(m.Database.ValueString()))
+}
+
+func (m FetchResourceModel) commitMessage(operation string) commitMessage {
+	address := m.Remote.ValueString()
+	if !m.Ref.IsNull() {
+		address = fmt.Sprintf("%s/%s", m.Remote.ValueString(), m.Ref.ValueString())
+	}
+	return commitMessage{
+		Type:      "dolt_fetch",
+		Database:  m.Database.ValueString(),
+		Address:   address,
+		Operation: operation,
+	}
+}
+
+func (m FetchResourceModel) fetchQuery() (string, []any) {
+	if m.Ref.IsNull() {
+		return "CALL DOLT_FETCH(?)", []any{m.Remote.ValueString()}
+	}
+	return "CALL DOLT_FETCH(?, ?)", []any{m.Remote.ValueString(), m.Ref.ValueString()}
+}
+
+func (m FetchResourceModel) hashQuery() (string, []any) {
+	return "SELECT HASHOF(?)", []any{fmt.Sprintf("%s/%s", m.Remote.ValueString(), m.Ref.ValueString())}
+}
+
+func (r *FetchResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_fetch"
+}
+
+func (r *FetchResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetch resource, updates the remote-tracking branches of a database without changing its own branches. " +
+			"The remote is fetched again whenever any of the arguments change, destroying the resource leaves the remote-tracking branches untouched.",
+
+		Attributes: map[string]schema.Attribute{
+			"database": schema.StringAttribute{
+				MarkdownDescription: "Name of the database to fetch into",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"remote": schema.StringAttribute{
+				MarkdownDescription: "Name of the remote to fetch from",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ref": schema.StringAttribute{
+				MarkdownDescription: "Branch of the remote to fetch, defaults to all branches",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values that cause the remote to be fetched again when changed",
+				ElementType:         types.StringType,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"hash": schema.StringAttribute{
+				MarkdownDescription: "Hash of the head of the fetched branch, null if all branches are fetched",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *FetchResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*DoltClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *DoltClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *FetchResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FetchResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tx, err := r.client.beginTx(ctx, data.commitMessage("create"))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to fetch, got error: %s", err))
+		return
+	}
+
+	_, err = tx.ExecContext(ctx, data.useQuery())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to fetch, got error: %s", err))
+		return
+	}
+
+	fetchQuery, args := data.fetchQuery()
+	_, err = tx.ExecContext(ctx, fetchQuery, args...)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to fetch, got error: %s", err))
+		return
+	}
+
+	data.Hash = types.StringNull()
+	if !data.Ref.IsNull() {
+		var hash string
+		hashQuery, args := data.hashQuery()
+		err = tx.QueryRowContext(ctx, hashQuery, args...).Scan(&hash)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to fetch, got error: %s", err))
+			return
+		}
+		data.Hash = types.StringValue(hash)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to fetch, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "fetched a remote")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FetchResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data FetchResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FetchResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data FetchResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FetchResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Trace(ctx, "removed a fetch from state, the remote-tracking branches are left untouched")
+}
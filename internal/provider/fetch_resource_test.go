@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFetchResource(t *testing.T) {
+	host, port := testAccRemoteServer(t)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderServerConfig(host, port) +
+					testAccFetchResourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("dolt_fetch.test", "hash", "data.dolt_log.golden", "commits.0.hash"),
+					resource.TestCheckNoResourceAttr("dolt_fetch.all", "hash"),
+					resource.TestCheckResourceAttr("data.dolt_log.replica", "commits.0.message", "Rename Alice"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFetchResourceConfig() string {
+	return `
+resource "dolt_fetch" "test" {
+  database = "replica"
+  remote   = "origin"
+  ref      = "main"
+}
+
+resource "dolt_fetch" "all" {
+  database = "replica"
+  remote   = "origin"
+}
+
+data "dolt_log" "golden" {
+  database = "golden"
+  limit    = 1
+}
+
+data "dolt_log" "replica" {
+  database = dolt_fetch.test.database
+  limit    = 1
+}
+`
+}
@@ -0,0 +1,252 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"strings"
+	"time"
+)
+
+var _ datasource.DataSource = &LogDataSource{}
+
+func NewLogDataSource() datasource.DataSource {
+	return &LogDataSource{}
+}
+
+type LogDataSource struct {
+	client *DoltClient
+}
+
+type LogDataSourceModel struct {
+	Database types.String `tfsdk:"database"`
+	Ref      types.String `tfsdk:"ref"`
+	Author   types.String `tfsdk:"author"`
+	Since    types.String `tfsdk:"since"`
+	Limit    types.Int64  `tfsdk:"limit"`
+	Tables   types.List   `tfsdk:"tables"`
+	Commits  types.List   `tfsdk:"commits"`
+}
+
+var logCommitType = basetypes.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"hash":      types.StringType,
+		"committer": types.StringType,
+		"email":     types.StringType,
+		"date":      types.StringType,
+		"message":   types.StringType,
+		"parents":   types.ListType{ElemType: types.StringType},
+	},
+}
+
+func (m LogDataSourceModel) useQuery() string {
+	return fmt.Sprintf("USE %s", quoteIdentifier(m.Database.ValueString()))
+}
+
+// readQuery selects the commits from the DOLT_LOG table function. Its arguments cannot be bound as placeholders,
+// so they are quoted as string literals, the filters on the result are bound as usual.
+func (m LogDataSourceModel) readQuery() (string, []any, error) {
+	functionArgs := []string{quoteString("HEAD"), quoteString("--parents")}
+	if !m.Ref.IsNull() {
+		functionArgs[0] = quoteString(m.Ref.ValueString())
+	}
+	if tables := stringElements(m.Tables); len(tables) > 0 {
+		functionArgs = append(functionArgs, quoteString("--tables"), quoteString(strings.Join(tables, ",")))
+	}
+
+	var conditions []string
+	var args []any
+	if !m.Author.IsNull() {
+		conditions = append(conditions, "(committer = ? OR email = ?)")
+		args = append(args, m.Author.ValueString(), m.Author.ValueString())
+	}
+	if !m.Since.IsNull() {
+		since, ok := parseTime(m.Since.ValueString())
+		if !ok {
+			return "", nil, fmt.Errorf("since needs to be a date or RFC 3339 timestamp, got: %s", m.Since.ValueString())
+		}
+		conditions = append(conditions, "date >= ?")
+		args = append(args, since)
+	}
+
+	query := fmt.Sprintf("SELECT commit_hash, committer, email, date, message, parents FROM DOLT_LOG(%s)", strings.Join(functionArgs, ", "))
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	if !m.Limit.IsNull() {
+		query += fmt.Sprintf(" LIMIT %d", m.Limit.ValueInt64())
+	}
+	return query, args, nil
+}
+
+func (d *LogDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_log"
+}
+
+func (d *LogDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Log data source, lists the commit history of a database starting with the most recent commit",
+
+		Attributes: map[string]schema.Attribute{
+			"database": schema.StringAttribute{
+				MarkdownDescription: "Name of the database",
+				Required:            true,
+			},
+			"ref": schema.StringAttribute{
+				MarkdownDescription: "Branch, tag or commit hash to start from, defaults to the head of the default branch",
+				Optional:            true,
+			},
+			"author": schema.StringAttribute{
+				MarkdownDescription: "Only list commits with this committer name or email",
+				Optional:            true,
+			},
+			"since": schema.StringAttribute{
+				MarkdownDescription: "Only list commits made at or after this date or RFC 3339 timestamp",
+				Optional:            true,
+			},
+			"limit": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of commits to list",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"tables": schema.ListAttribute{
+				MarkdownDescription: "Only list commits that changed one of these tables",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"commits": schema.ListNestedAttribute{
+				MarkdownDescription: "Commits starting with the most recent one",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"hash": schema.StringAttribute{
+							MarkdownDescription: "Hash of the commit",
+							Computed:            true,
+						},
+						"committer": schema.StringAttribute{
+							MarkdownDescription: "Name of the committer",
+							Computed:            true,
+						},
+						"email": schema.StringAttribute{
+							MarkdownDescription: "Email of the committer",
+							Computed:            true,
+						},
+						"date": schema.StringAttribute{
+							MarkdownDescription: "Date of the commit as RFC 3339 timestamp",
+							Computed:            true,
+						},
+						"message": schema.StringAttribute{
+							MarkdownDescription: "Commit message",
+							Computed:            true,
+						},
+						"parents": schema.ListAttribute{
+							MarkdownDescription: "Hashes of the parent commits, two for merge commits",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *LogDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*DoltClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *DoltClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *LogDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data LogDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readQuery, args, err := data.readQuery()
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Log Filter", err.Error())
+		return
+	}
+
+	conn, err := d.client.db.Conn(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read log, got error: %s", err))
+		return
+	}
+	defer conn.Close()
+
+	_, err = conn.ExecContext(ctx, data.useQuery())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read log, got error: %s", err))
+		return
+	}
+
+	result, err := conn.QueryContext(ctx, readQuery, args...)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read log, got error: %s", err))
+		return
+	}
+	defer result.Close()
+
+	var commits []attr.Value
+	for result.Next() {
+		var hash, committer, email, message, parents string
+		var date time.Time
+		err := result.Scan(&hash, &committer, &email, &date, &message, &parents)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read log, got error: %s", err))
+			return
+		}
+		var parentHashes []attr.Value
+		for _, parent := range strings.Split(parents, ",") {
+			if parent = strings.TrimSpace(parent); parent != "" {
+				parentHashes = append(parentHashes, types.StringValue(parent))
+			}
+		}
+		commit, diagnostics := types.ObjectValue(logCommitType.AttrTypes, map[string]attr.Value{
+			"hash":      types.StringValue(hash),
+			"committer": types.StringValue(committer),
+			"email":     types.StringValue(email),
+			"date":      types.StringValue(date.UTC().Format(time.RFC3339Nano)),
+			"message":   types.StringValue(message),
+			"parents":   types.ListValueMust(types.StringType, parentHashes),
+		})
+		resp.Diagnostics.Append(diagnostics...)
+		commits = append(commits, commit)
+	}
+	if err := result.Err(); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read log, got error: %s", err))
+		return
+	}
+
+	commitsList, diagnostics := types.ListValue(logCommitType, commits)
+	resp.Diagnostics.Append(diagnostics...)
+	data.Commits = commitsList
+
+	tflog.Trace(ctx, "read a data source")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
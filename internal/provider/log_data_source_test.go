@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccLogDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() +
+					testAccDatabaseResourceConfig() +
+					testAccTableResourceConfig() +
+					testAccRowSetResourceConfigTwo() +
+					testAccLogDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.dolt_log.latest", "commits.#", "1"),
+					resource.TestCheckResourceAttr("data.dolt_log.latest", "commits.0.message", "terraform: create dolt_rowset test_table"),
+					resource.TestCheckResourceAttr("data.dolt_log.latest", "commits.0.committer", "Test Example"),
+					resource.TestCheckResourceAttr("data.dolt_log.latest", "commits.0.email", "test@example.com"),
+					resource.TestCheckResourceAttr("data.dolt_log.latest", "commits.0.parents.#", "1"),
+					resource.TestMatchResourceAttr("data.dolt_log.latest", "commits.0.hash", regexp.MustCompile("^[0-9a-v]{32}$")),
+					resource.TestMatchResourceAttr("data.dolt_log.latest", "commits.0.date", regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T`)),
+					resource.TestCheckResourceAttr("data.dolt_log.filtered", "commits.#", "2"),
+					resource.TestCheckResourceAttr("data.dolt_log.filtered", "commits.1.message", "terraform: create dolt_table test_table"),
+					resource.TestCheckResourceAttr("data.dolt_log.future", "commits.#", "0"),
+				),
+			},
+			{
+				Config: testAccProviderConfig() +
+					testAccDatabaseResourceConfig() +
+					testAccTableResourceConfig() +
+					testAccRowSetResourceConfigTwo() +
+					testAccLogDataSourceQuotedRefConfig(),
+				ExpectError: regexp.MustCompile("branch not found: it's"),
+			},
+		},
+	})
+}
+
+func testAccLogDataSourceQuotedRefConfig() string {
+	return `
+data "dolt_log" "quoted" {
+  database = dolt_rowset.test.database
+  ref      = "it's"
+}
+`
+}
+
+func TestLogDataSourceReadQuery(t *testing.T) {
+	model := LogDataSourceModel{
+		Database: types.StringValue("test"),
+		Ref:      types.StringValue(`it's\`),
+		Author:   types.StringNull(),
+		Since:    types.StringNull(),
+		Limit:    types.Int64Null(),
+		Tables:   types.ListValueMust(types.StringType, []attr.Value{types.StringValue(`a'b\`)}),
+	}
+	query, _, err := model.readQuery()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `SELECT commit_hash, committer, email, date, message, parents FROM DOLT_LOG('it''s\\', '--parents', '--tables', 'a''b\\')`
+	if query != expected {
+		t.Errorf("expected %s, got %s", expected, query)
+	}
+}
+
+func testAccLogDataSourceConfig() string {
+	return `
+data "dolt_log" "latest" {
+  database = dolt_rowset.test.database
+  limit    = 1
+}
+
+data "dolt_log" "filtered" {
+  database = dolt_rowset.test.database
+  ref      = "main"
+  author   = "test@example.com"
+  since    = "2000-01-01"
+  tables   = [dolt_rowset.test.table]
+}
+
+data "dolt_log" "future" {
+  database = dolt_rowset.test.database
+  since    = "2999-01-01T00:00:00Z"
+}
+`
+}
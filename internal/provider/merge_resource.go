@@ -0,0 +1,485 @@
+package provider
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"strings"
+)
+
+const (
+	mergeStrategyFastForwardOnly = "ff-only"
+	mergeStrategyNoFastForward   = "no-ff"
+	mergeStrategySquash          = "squash"
+)
+
+const (
+	conflictResolutionFail   = "fail"
+	conflictResolutionOurs   = "ours"
+	conflictResolutionTheirs = "theirs"
+)
+
+var _ resource.Resource = &MergeResource{}
+
+func NewMergeResource() resource.Resource {
+	return &MergeResource{}
+}
+
+type MergeResource struct {
+	client *DoltClient
+}
+
+type MergeResourceModel struct {
+	Database             types.String `tfsdk:"database"`
+	Branch               types.String `tfsdk:"branch"`
+	Source               types.String `tfsdk:"source"`
+	Strategy             types.String `tfsdk:"strategy"`
+	ConflictResolution   types.String `tfsdk:"conflict_resolution"`
+	Message              types.String `tfsdk:"message"`
+	Triggers             types.Map    `tfsdk:"triggers"`
+	Hash                 types.String `tfsdk:"hash"`
+	Conflicts            types.List   `tfsdk:"conflicts"`
+	ConstraintViolations types.List   `tfsdk:"constraint_violations"`
+}
+
+var mergeTableCountType = basetypes.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"table": types.StringType,
+		"count": types.Int64Type,
+	},
+}
+
+// mergeTableCount is the number of conflicts or constraint violations a merge left in a table.
+type mergeTableCount struct {
+	table string
+	count int64
+}
+
+func (m MergeResourceModel) useQuery() string {
+	return fmt.Sprintf("USE %s", quoteIdentifier(revisionDatabase(m.Database, m.Branch)))
+}
+
+func (m MergeResourceModel) commitMessage(operation string) commitMessage {
+	return commitMessage{
+		Type:      "dolt_merge",
+		Database:  m.Database.ValueString(),
+		Address:   revisionDatabase(m.Database, m.Branch),
+		Operation: operation,
+	}
+}
+
+// message returns the configured message or the one Dolt uses for merge commits.
+func (m MergeResourceModel) message() string {
+	if !m.Message.IsNull() {
+		return m.Message.ValueString()
+	}
+	if m.Branch.IsNull() {
+		return fmt.Sprintf("Merge branch '%s'", m.Source.ValueString())
+	}
+	return fmt.Sprintf("Merge branch '%s' into %s", m.Source.ValueString(), m.Branch.ValueString())
+}
+
+// mergeQuery doesn't use --ff-only, it is not supported by all Dolt versions and checked before merging instead.
+func (m MergeResourceModel) mergeQuery() (string, []any) {
+	args := []any{m.Source.ValueString(), "-m", m.message()}
+	switch m.Strategy.ValueString() {
+	case mergeStrategyNoFastForward:
+		args = append(args, "--no-ff")
+	case mergeStrategySquash:
+		args = append(args, "--squash")
+	}
+	return fmt.Sprintf("CALL DOLT_MERGE(%s)", placeholders(len(args))), args
+}
+
+func (r *MergeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_merge"
+}
+
+func (r *MergeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Merge resource, merges a source branch into a target branch, which must not have uncommitted changes. " +
+			"The branches are merged again whenever any of the arguments change, destroying the resource leaves the commit history untouched.",
+
+		Attributes: map[string]schema.Attribute{
+			"database": schema.StringAttribute{
+				MarkdownDescription: "Name of the database to merge in",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"branch": schema.StringAttribute{
+				MarkdownDescription: "Branch to merge into, defaults to the default branch",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source": schema.StringAttribute{
+				MarkdownDescription: "Branch, tag or commit hash to merge",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"strategy": schema.StringAttribute{
+				MarkdownDescription: "How to merge, one of `ff-only` to fail unless the branch can be fast-forwarded, `no-ff` to always create a merge commit " +
+					"or `squash` to create a single commit without the source as parent. Defaults to fast-forwarding when possible",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(mergeStrategyFastForwardOnly, mergeStrategyNoFastForward, mergeStrategySquash),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"conflict_resolution": schema.StringAttribute{
+				MarkdownDescription: "How to handle conflicts, one of `fail` (default) to leave the branch untouched, " +
+					"`ours` to keep the rows of the branch or `theirs` to take the rows of the source. " +
+					"With `fail` constraint violations leave the branch untouched as well, otherwise they are committed with the merge and reported in `constraint_violations`",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(conflictResolutionFail),
+				Validators: []validator.String{
+					stringvalidator.OneOf(conflictResolutionFail, conflictResolutionOurs, conflictResolutionTheirs),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"message": schema.StringAttribute{
+				MarkdownDescription: "Message of the merge commit, defaults to the message Dolt uses for merges",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values that cause the branches to be merged again when changed",
+				ElementType:         types.StringType,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"hash": schema.StringAttribute{
+				MarkdownDescription: "Hash of the head of the branch after merging",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"conflicts": schema.ListNestedAttribute{
+				MarkdownDescription: "Tables with conflicts that were resolved according to `conflict_resolution`",
+				Computed:            true,
+				NestedObject:        mergeTableCountAttribute("Number of conflicting rows"),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"constraint_violations": schema.ListNestedAttribute{
+				MarkdownDescription: "Tables with constraint violations that were committed with the merge, " +
+					"they are kept in `dolt_constraint_violations` until they are fixed",
+				Computed:     true,
+				NestedObject: mergeTableCountAttribute("Number of rows violating constraints"),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func mergeTableCountAttribute(countDescription string) schema.NestedAttributeObject {
+	return schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"table": schema.StringAttribute{
+				MarkdownDescription: "Name of the table",
+				Computed:            true,
+			},
+			"count": schema.Int64Attribute{
+				MarkdownDescription: countDescription,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *MergeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*DoltClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *DoltClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *MergeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data MergeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tx, err := r.client.beginTx(ctx, data.commitMessage("create"))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to merge, got error: %s", err))
+		return
+	}
+	// A failed merge leaves conflicts in the working set of the transaction, they must not be committed
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	_, err = tx.ExecContext(ctx, data.useQuery())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to merge, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(checkWorkingSetClean(ctx, tx, revisionDatabase(data.Database, data.Branch))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Strategy.ValueString() == mergeStrategyFastForwardOnly {
+		var fastForward bool
+		fastForwardQuery, args := fastForwardQuery(data.Source.ValueString())
+		err = tx.QueryRowContext(ctx, fastForwardQuery, args...).Scan(&fastForward)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to merge, got error: %s", err))
+			return
+		}
+		if !fastForward {
+			resp.Diagnostics.AddError("Cannot Fast-Forward", fmt.Sprintf("Branch %s has diverged from %s, it can only be merged with a different strategy", revisionDatabase(data.Database, data.Branch), data.Source.ValueString()))
+			return
+		}
+	}
+
+	var hash, message string
+	var fastForward, conflicts int64
+	mergeQuery, args := data.mergeQuery()
+	err = tx.QueryRowContext(ctx, mergeQuery, args...).Scan(&hash, &fastForward, &conflicts, &message)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to merge, got error: %s", err))
+		return
+	}
+
+	resolution := data.ConflictResolution.ValueString()
+	conflictCounts, violationCounts, diagnostics := completeMerge(ctx, tx, data.Source.ValueString(), resolution, resolution != conflictResolutionFail, data.message())
+	resp.Diagnostics.Append(diagnostics...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err = tx.QueryRowContext(ctx, "SELECT HASHOF('HEAD')").Scan(&hash)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to merge, got error: %s", err))
+		return
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to merge, got error: %s", err))
+		return
+	}
+
+	data.Hash = types.StringValue(hash)
+	data.Conflicts, diagnostics = mergeTableCountList(conflictCounts)
+	resp.Diagnostics.Append(diagnostics...)
+	data.ConstraintViolations, diagnostics = mergeTableCountList(violationCounts)
+	resp.Diagnostics.Append(diagnostics...)
+
+	tflog.Trace(ctx, "merged a branch")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MergeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data MergeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MergeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data MergeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MergeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Trace(ctx, "removed a merge from state, the commit history is left untouched")
+}
+
+// checkWorkingSetClean fails if the current branch has uncommitted changes. Merges commit the whole working set
+// when they leave changes to commit, so the uncommitted changes would end up in the merge commit otherwise.
+func checkWorkingSetClean(ctx context.Context, tx *sql.Tx, branch string) diag.Diagnostics {
+	var diagnostics diag.Diagnostics
+
+	var tables []string
+	rows, err := tx.QueryContext(ctx, "SELECT table_name FROM dolt_status ORDER BY table_name")
+	if err != nil {
+		diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read status, got error: %s", err))
+		return diagnostics
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var table string
+		err = rows.Scan(&table)
+		if err != nil {
+			diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read status, got error: %s", err))
+			return diagnostics
+		}
+		tables = append(tables, table)
+	}
+	if err = rows.Err(); err != nil {
+		diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read status, got error: %s", err))
+		return diagnostics
+	}
+
+	if len(tables) > 0 {
+		diagnostics.AddError("Uncommitted Changes", fmt.Sprintf("Branch %s has uncommitted changes in %s, commit or discard them before merging into it", branch, strings.Join(tables, ", ")))
+	}
+	return diagnostics
+}
+
+// completeMerge handles the conflicts and constraint violations a merge left in the working set of the transaction,
+// resolves conflicts according to the resolution and commits the merge unless it was already committed.
+// Constraint violations fail the merge unless commitViolations is set, then they are committed with the merge.
+// The working set must be clean before merging, everything left in it afterwards is the result of the merge.
+func completeMerge(ctx context.Context, tx *sql.Tx, source, resolution string, commitViolations bool, message string) ([]mergeTableCount, []mergeTableCount, diag.Diagnostics) {
+	var diagnostics diag.Diagnostics
+
+	conflicts, err := readMergeTableCounts(ctx, tx, "SELECT `table`, num_conflicts FROM dolt_conflicts ORDER BY `table`")
+	if err != nil {
+		diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read merge conflicts, got error: %s", err))
+		return nil, nil, diagnostics
+	}
+	violations, err := readMergeTableCounts(ctx, tx, "SELECT `table`, num_violations FROM dolt_constraint_violations ORDER BY `table`")
+	if err != nil {
+		diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read merge constraint violations, got error: %s", err))
+		return nil, nil, diagnostics
+	}
+
+	if len(violations) > 0 && !commitViolations {
+		diagnostics.AddError("Merge Constraint Violations", fmt.Sprintf("Merging %s results in constraint violations in %s, the branch is left untouched", source, formatMergeTableCounts(violations)))
+		return nil, nil, diagnostics
+	}
+	if len(conflicts) > 0 && resolution == conflictResolutionFail {
+		diagnostics.AddError("Merge Conflicts", fmt.Sprintf("Merging %s results in conflicts in %s, the branch is left untouched. Set conflict_resolution to ours or theirs to resolve them", source, formatMergeTableCounts(conflicts)))
+		return nil, nil, diagnostics
+	}
+
+	for _, conflict := range conflicts {
+		resolveQuery, args := resolveConflictsQuery(resolution, conflict.table)
+		_, err = tx.ExecContext(ctx, resolveQuery, args...)
+		if err != nil {
+			diagnostics.AddError("Client Error", fmt.Sprintf("Unable to resolve conflicts in table %s, got error: %s", conflict.table, err))
+			return nil, nil, diagnostics
+		}
+	}
+
+	// Merges with resolved conflicts and squash merges leave their changes uncommitted
+	var changes int
+	err = tx.QueryRowContext(ctx, "SELECT COUNT(*) FROM dolt_status").Scan(&changes)
+	if err != nil {
+		diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read merge status, got error: %s", err))
+		return nil, nil, diagnostics
+	}
+	if len(conflicts) > 0 || changes > 0 {
+		commitQuery := "CALL DOLT_COMMIT('-A', '-m', ?)"
+		if len(violations) > 0 {
+			commitQuery = "CALL DOLT_COMMIT('-A', '--force', '-m', ?)"
+		}
+		_, err = tx.ExecContext(ctx, commitQuery, message)
+		if err != nil {
+			diagnostics.AddError("Client Error", fmt.Sprintf("Unable to commit merge, got error: %s", err))
+			return nil, nil, diagnostics
+		}
+	}
+
+	return conflicts, violations, diagnostics
+}
+
+// fastForwardQuery checks whether the head of the current branch is an ancestor of the source, so merging only moves the branch.
+func fastForwardQuery(source string) (string, []any) {
+	return "SELECT HASHOF('HEAD') = DOLT_MERGE_BASE('HEAD', ?)", []any{source}
+}
+
+func resolveConflictsQuery(resolution, table string) (string, []any) {
+	return "CALL DOLT_CONFLICTS_RESOLVE(?, ?)", []any{"--" + resolution, table}
+}
+
+func readMergeTableCounts(ctx context.Context, tx *sql.Tx, query string) ([]mergeTableCount, error) {
+	rows, err := tx.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var counts []mergeTableCount
+	for rows.Next() {
+		var count mergeTableCount
+		err := rows.Scan(&count.table, &count.count)
+		if err != nil {
+			return nil, err
+		}
+		counts = append(counts, count)
+	}
+	return counts, rows.Err()
+}
+
+func formatMergeTableCounts(counts []mergeTableCount) string {
+	var tables []string
+	for _, count := range counts {
+		tables = append(tables, fmt.Sprintf("%s (%d rows)", count.table, count.count))
+	}
+	return strings.Join(tables, ", ")
+}
+
+func mergeTableCountList(counts []mergeTableCount) (types.List, diag.Diagnostics) {
+	var diagnostics diag.Diagnostics
+	var values []attr.Value
+	for _, count := range counts {
+		value, d := types.ObjectValue(mergeTableCountType.AttrTypes, map[string]attr.Value{
+			"table": types.StringValue(count.table),
+			"count": types.Int64Value(count.count),
+		})
+		diagnostics.Append(d...)
+		values = append(values, value)
+	}
+	list, d := types.ListValue(mergeTableCountType, values)
+	diagnostics.Append(d...)
+	return list, diagnostics
+}
@@ -0,0 +1,189 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccMergeResource(t *testing.T) {
+	host, port := testAccDoltServer(t)
+	testAccServerExec(t, host, port,
+		"CREATE DATABASE test",
+		"USE test",
+		"CREATE TABLE test_table (id INT PRIMARY KEY, name VARCHAR(100))",
+		"CALL DOLT_COMMIT('-A', '-m', 'Create test_table')",
+		"CALL DOLT_BRANCH('feature')",
+		"INSERT INTO test_table VALUES (1, 'Alicia')",
+		"CALL DOLT_COMMIT('-A', '-m', 'Add Alicia')",
+		"CALL DOLT_CHECKOUT('feature')",
+		"INSERT INTO test_table VALUES (1, 'Alice'), (2, 'Bob')",
+		"CALL DOLT_COMMIT('-A', '-m', 'Add Alice and Bob')",
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderServerConfig(host, port) +
+					testAccMergeResourceConfig(`strategy = "ff-only"`),
+				ExpectError: regexp.MustCompile("Cannot Fast-Forward"),
+			},
+			{
+				Config: testAccProviderServerConfig(host, port) +
+					testAccMergeResourceConfig(""),
+				ExpectError: regexp.MustCompile(`conflicts in test_table \(1 rows\)`),
+			},
+			{
+				Config: testAccProviderServerConfig(host, port) +
+					testAccMergeResourceConfig(`conflict_resolution = "theirs"`) +
+					testAccMergeResourceQueryConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dolt_merge.test", "conflicts.#", "1"),
+					resource.TestCheckResourceAttr("dolt_merge.test", "conflicts.0.table", "test_table"),
+					resource.TestCheckResourceAttr("dolt_merge.test", "conflicts.0.count", "1"),
+					resource.TestCheckResourceAttr("dolt_merge.test", "constraint_violations.#", "0"),
+					resource.TestCheckResourceAttrPair("dolt_merge.test", "hash", "data.dolt_log.test", "commits.0.hash"),
+					resource.TestCheckResourceAttr("data.dolt_log.test", "commits.0.message", "Promote feature"),
+					resource.TestCheckResourceAttr("data.dolt_log.test", "commits.0.parents.#", "2"),
+					resource.TestCheckResourceAttr("data.dolt_query.test", "rows.#", "2"),
+					resource.TestCheckResourceAttr("data.dolt_query.test", "rows.0.name", "Alice"),
+					resource.TestCheckResourceAttr("data.dolt_query.test", "rows.1.name", "Bob"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMergeResourceStrategies(t *testing.T) {
+	host, port := testAccDoltServer(t)
+	testAccServerExec(t, host, port,
+		"CREATE DATABASE test",
+		"USE test",
+		"CREATE TABLE test_table (id INT PRIMARY KEY, name VARCHAR(100))",
+		"CALL DOLT_COMMIT('-A', '-m', 'Create test_table')",
+		"CALL DOLT_BRANCH('feature')",
+		"CALL DOLT_CHECKOUT('feature')",
+		"INSERT INTO test_table VALUES (1, 'Alice')",
+		"CALL DOLT_COMMIT('-A', '-m', 'Add Alice')",
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					testAccServerExec(t, host, port, "USE test", "INSERT INTO test_table VALUES (9, 'Uncommitted')")
+				},
+				Config: testAccProviderServerConfig(host, port) +
+					testAccMergeResourceConfig(`strategy = "squash"`),
+				ExpectError: regexp.MustCompile(`Branch test/main has uncommitted changes in test_table`),
+			},
+			{
+				PreConfig: func() {
+					testAccServerExec(t, host, port, "USE test", "CALL DOLT_RESET('--hard')")
+				},
+				Config: testAccProviderServerConfig(host, port) +
+					testAccMergeResourceConfig(`strategy = "squash"`) +
+					testAccMergeResourceQueryConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dolt_merge.test", "conflicts.#", "0"),
+					resource.TestCheckResourceAttr("dolt_merge.test", "constraint_violations.#", "0"),
+					resource.TestCheckResourceAttrPair("dolt_merge.test", "hash", "data.dolt_log.test", "commits.0.hash"),
+					resource.TestCheckResourceAttr("data.dolt_log.test", "commits.0.message", "Promote feature"),
+					resource.TestCheckResourceAttr("data.dolt_log.test", "commits.0.parents.#", "1"),
+					resource.TestCheckResourceAttr("data.dolt_query.test", "rows.#", "1"),
+				),
+			},
+			{
+				Config: testAccProviderServerConfig(host, port) +
+					testAccMergeResourceConfig(`strategy = "no-ff"
+  triggers = {
+    run = "2"
+  }`) +
+					testAccMergeResourceQueryConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("dolt_merge.test", "hash", "data.dolt_log.test", "commits.0.hash"),
+					resource.TestCheckResourceAttr("data.dolt_log.test", "commits.0.parents.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMergeResourceConstraintViolations(t *testing.T) {
+	host, port := testAccDoltServer(t)
+	testAccServerExec(t, host, port,
+		"CREATE DATABASE test",
+		"USE test",
+		"CREATE TABLE parent (id INT PRIMARY KEY)",
+		"CREATE TABLE child (id INT PRIMARY KEY, parent_id INT, FOREIGN KEY (parent_id) REFERENCES parent (id))",
+		"INSERT INTO parent VALUES (1), (2)",
+		"CALL DOLT_COMMIT('-A', '-m', 'Create parent and child')",
+		"CALL DOLT_BRANCH('feature')",
+		"INSERT INTO child VALUES (1, 1)",
+		"CALL DOLT_COMMIT('-A', '-m', 'Add child')",
+		"CALL DOLT_CHECKOUT('feature')",
+		"DELETE FROM parent WHERE id = 1",
+		"CALL DOLT_COMMIT('-A', '-m', 'Delete parent')",
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderServerConfig(host, port) +
+					testAccMergeResourceConfig(""),
+				ExpectError: regexp.MustCompile(`constraint violations in child \(1 rows\)`),
+			},
+			{
+				Config: testAccProviderServerConfig(host, port) +
+					testAccMergeResourceConfig(`conflict_resolution = "theirs"`) + `
+data "dolt_log" "test" {
+  database = dolt_merge.test.database
+  limit    = 1
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dolt_merge.test", "conflicts.#", "0"),
+					resource.TestCheckResourceAttr("dolt_merge.test", "constraint_violations.#", "1"),
+					resource.TestCheckResourceAttr("dolt_merge.test", "constraint_violations.0.table", "child"),
+					resource.TestCheckResourceAttr("dolt_merge.test", "constraint_violations.0.count", "1"),
+					resource.TestCheckResourceAttrPair("dolt_merge.test", "hash", "data.dolt_log.test", "commits.0.hash"),
+					resource.TestCheckResourceAttr("data.dolt_log.test", "commits.0.parents.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMergeResourceConfig(options string) string {
+	return fmt.Sprintf(`
+resource "dolt_merge" "test" {
+  database = "test"
+  branch   = "main"
+  source   = "feature"
+  message  = "Promote feature"
+  %s
+}
+`, options)
+}
+
+func testAccMergeResourceQueryConfig() string {
+	return `
+data "dolt_log" "test" {
+  database = dolt_merge.test.database
+  limit    = 1
+}
+
+data "dolt_query" "test" {
+  database = dolt_merge.test.database
+  query    = "SELECT id, name FROM test_table ORDER BY id"
+}
+`
+}
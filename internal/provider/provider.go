@@ -5,18 +5,28 @@ import (
 	"database/sql"
 	"fmt"
 	_ "github.com/dolthub/driver"
+	"github.com/go-sql-driver/mysql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/providervalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"net"
+	"net/url"
 	"os"
 	"path/filepath"
+	"strconv"
 )
 
 var _ provider.Provider = &DoltProvider{}
 var _ provider.ProviderWithFunctions = &DoltProvider{}
+var _ provider.ProviderWithConfigValidators = &DoltProvider{}
 
 type DoltProvider struct {
 	version string
@@ -24,9 +34,44 @@ type DoltProvider struct {
 
 // DoltProviderModel TODO maybe path should be part of a resource rather than a provider config?
 type DoltProviderModel struct {
-	Path  types.String `tfsdk:"path"`
-	Name  types.String `tfsdk:"name"`
-	Email types.String `tfsdk:"email"`
+	Path                  types.String     `tfsdk:"path"`
+	Name                  types.String     `tfsdk:"name"`
+	Email                 types.String     `tfsdk:"email"`
+	CommitMode            types.String     `tfsdk:"commit_mode"`
+	CommitMessageTemplate types.String     `tfsdk:"commit_message_template"`
+	Server                *DoltServerModel `tfsdk:"server"`
+}
+
+// DoltServerModel describes a running dolt sql-server that is used instead of the databases in path.
+type DoltServerModel struct {
+	Host     types.String `tfsdk:"host"`
+	Port     types.Int64  `tfsdk:"port"`
+	User     types.String `tfsdk:"user"`
+	Password types.String `tfsdk:"password"`
+	TLS      types.String `tfsdk:"tls"`
+}
+
+func (m DoltServerModel) dataSourceName() string {
+	port := int64(3306)
+	if !m.Port.IsNull() {
+		port = m.Port.ValueInt64()
+	}
+	user := "root"
+	if !m.User.IsNull() {
+		user = m.User.ValueString()
+	}
+	tls := "false"
+	if !m.TLS.IsNull() {
+		tls = m.TLS.ValueString()
+	}
+	config := mysql.NewConfig()
+	config.Net = "tcp"
+	config.Addr = net.JoinHostPort(m.Host.ValueString(), strconv.FormatInt(port, 10))
+	config.User = user
+	config.Passwd = m.Password.ValueString()
+	config.TLSConfig = tls
+	config.ParseTime = true
+	return config.FormatDSN()
 }
 
 func (m DoltProviderModel) databaseUrl() (string, error) {
@@ -34,7 +79,7 @@ func (m DoltProviderModel) databaseUrl() (string, error) {
 	if err != nil {
 		return "", err
 	}
-	return fmt.Sprintf("file://%s?commitname=%s&commitemail=%s", path, m.Name.ValueString(), m.Email.ValueString()), nil
+	return fmt.Sprintf("file://%s?commitname=%s&commitemail=%s", path, url.QueryEscape(m.Name.ValueString()), url.QueryEscape(m.Email.ValueString())), nil
 }
 
 func (p *DoltProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -46,21 +91,81 @@ func (p *DoltProvider) Schema(ctx context.Context, req provider.SchemaRequest, r
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"path": schema.StringAttribute{
-				MarkdownDescription: "Path to the directory where your databases are on disk",
-				Required:            true,
+				MarkdownDescription: "Path to the directory where your databases are on disk, conflicts with `server`",
+				Optional:            true,
 			},
 			"name": schema.StringAttribute{
-				MarkdownDescription: "The name of the committer seen in the dolt commit log",
-				Required:            true,
+				MarkdownDescription: "The name of the committer seen in the dolt commit log, required with `path`",
+				Optional:            true,
 			},
 			"email": schema.StringAttribute{
-				MarkdownDescription: "The email of the committer seen in the dolt commit log",
-				Required:            true,
+				MarkdownDescription: "The email of the committer seen in the dolt commit log, required with `path`",
+				Optional:            true,
+			},
+			"commit_mode": schema.StringAttribute{
+				MarkdownDescription: "How changes made by resources are committed to the dolt commit log. " +
+					"`per_transaction` (default) creates one commit for every SQL transaction, " +
+					"`none` leaves changes in the working set so they can be committed with `dolt_commit`",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(commitModePerTransaction, commitModeNone),
+				},
+			},
+			"commit_message_template": schema.StringAttribute{
+				MarkdownDescription: "Go template for the messages of commits created in `per_transaction` mode. " +
+					"It can reference `{{.Type}}`, `{{.Database}}`, `{{.Address}}` (the object managed by the resource, e.g. the table of a `dolt_rowset`) " +
+					"and `{{.Operation}}` (`create`, `update` or `delete`). " +
+					"Defaults to `" + defaultCommitMessageTemplate + "`",
+				Optional: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"server": schema.SingleNestedBlock{
+				MarkdownDescription: "Connection to a running dolt sql-server, conflicts with `path`. " +
+					"Commits are attributed to the SQL user rather than `name` and `email`",
+				Validators: []validator.Object{
+					objectvalidator.AlsoRequires(path.MatchRelative().AtName("host")),
+				},
+				Attributes: map[string]schema.Attribute{
+					"host": schema.StringAttribute{
+						MarkdownDescription: "Host name or IP address of the server, required within `server`",
+						Optional:            true,
+					},
+					"port": schema.Int64Attribute{
+						MarkdownDescription: "Port of the server, defaults to `3306`",
+						Optional:            true,
+					},
+					"user": schema.StringAttribute{
+						MarkdownDescription: "User to connect as, defaults to `root`",
+						Optional:            true,
+					},
+					"password": schema.StringAttribute{
+						MarkdownDescription: "Password of the user",
+						Optional:            true,
+						Sensitive:           true,
+					},
+					"tls": schema.StringAttribute{
+						MarkdownDescription: "Whether to connect using TLS, one of `true`, `false` (default), `skip-verify` or `preferred`",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("true", "false", "skip-verify", "preferred"),
+						},
+					},
+				},
 			},
 		},
 	}
 }
 
+func (p *DoltProvider) ConfigValidators(ctx context.Context) []provider.ConfigValidator {
+	return []provider.ConfigValidator{
+		providervalidator.ExactlyOneOf(
+			path.MatchRoot("path"),
+			path.MatchRoot("server"),
+		),
+	}
+}
+
 func (p *DoltProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
 	var data DoltProviderModel
 
@@ -70,31 +175,94 @@ func (p *DoltProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 		return
 	}
 
+	var db *sql.DB
+	if data.Server != nil {
+		db = p.openServer(ctx, data, resp)
+	} else {
+		db = p.openPath(data, resp)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	commitMode := commitModePerTransaction
+	if !data.CommitMode.IsNull() {
+		commitMode = data.CommitMode.ValueString()
+	}
+
+	commitMessageTemplate := defaultCommitMessageTemplate
+	if !data.CommitMessageTemplate.IsNull() {
+		commitMessageTemplate = data.CommitMessageTemplate.ValueString()
+	}
+
+	client, err := newDoltClient(db, data.Server != nil, commitMode, commitMessageTemplate)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("commit_message_template"), "Invalid Commit Message Template", fmt.Sprintf("Unable to configure provider, %s", err))
+		return
+	}
+
+	transactionCommit := 1
+	if commitMode == commitModeNone {
+		transactionCommit = 0
+	}
+	_, err = db.ExecContext(ctx, fmt.Sprintf("SET @@dolt_transaction_commit=%d", transactionCommit))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to configure provider, cannot set commit mode %s: %s", commitMode, err))
+		return
+	}
+
+	resp.DataSourceData = client
+	resp.ResourceData = client
+}
+
+func (p *DoltProvider) openPath(data DoltProviderModel, resp *provider.ConfigureResponse) *sql.DB {
+	if data.Name.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("name"), "Missing Committer Name", "Unable to configure provider, name is required when using path")
+	}
+	if data.Email.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("email"), "Missing Committer Email", "Unable to configure provider, email is required when using path")
+	}
+	if resp.Diagnostics.HasError() {
+		return nil
+	}
+
 	_, err := os.Stat(data.Path.ValueString())
 	if os.IsNotExist(err) {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to configure provider, path doesn't exist: %s", data.Path.ValueString()))
-		return
+		return nil
 	}
 
 	url, err := data.databaseUrl()
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to configure provider, cannot produce database url for path: %s", data.Path.ValueString()))
-		return
+		return nil
 	}
 
 	db, err := sql.Open("dolt", url)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to configure provider, cannot open database: %s", err))
-		return
+		return nil
 	}
+	// Embedded sessions load the remotes of a database the first time they use it and never reload them,
+	// so a pooled session can't push to or fetch from a remote that was added by another session afterwards.
+	// Any resource may get any pooled session, so sessions are closed when they are released instead of kept idle.
+	db.SetMaxIdleConns(0)
+	return db
+}
 
-	_, err = db.ExecContext(ctx, "SET @@dolt_transaction_commit=1")
+func (p *DoltProvider) openServer(ctx context.Context, data DoltProviderModel, resp *provider.ConfigureResponse) *sql.DB {
+	db, err := sql.Open("mysql", data.Server.dataSourceName())
 	if err != nil {
-		return
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to configure provider, cannot open server connection: %s", err))
+		return nil
 	}
 
-	resp.DataSourceData = db
-	resp.ResourceData = db
+	err = db.PingContext(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to configure provider, cannot connect to server %s: %s", data.Server.Host.ValueString(), err))
+		return nil
+	}
+	return db
 }
 
 func (p *DoltProvider) Resources(ctx context.Context) []func() resource.Resource {
@@ -103,6 +271,15 @@ func (p *DoltProvider) Resources(ctx context.Context) []func() resource.Resource
 		NewTableResource,
 		NewViewResource,
 		NewRowSetResource,
+		NewBranchResource,
+		NewTagResource,
+		NewMergeResource,
+		NewRemoteResource,
+		NewPushResource,
+		NewFetchResource,
+		NewPullResource,
+		NewBackupResource,
+		NewCommitResource,
 	}
 }
 
@@ -110,6 +287,9 @@ func (p *DoltProvider) DataSources(ctx context.Context) []func() datasource.Data
 	return []func() datasource.DataSource{
 		NewDatabaseDataSource,
 		NewTableDataSource,
+		NewQueryDataSource,
+		NewLogDataSource,
+		NewDiffDataSource,
 	}
 }
 
@@ -1,10 +1,28 @@
 package provider
 
 import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net"
+	"path/filepath"
+	"regexp"
+	"strings"
 	"testing"
 
+	"github.com/dolthub/dolt/go/cmd/dolt/commands/engine"
+	"github.com/dolthub/dolt/go/libraries/utils/config"
+	"github.com/dolthub/dolt/go/libraries/utils/filesys"
+	embedded "github.com/dolthub/driver"
+	"github.com/dolthub/go-mysql-server/server"
+	gmssql "github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/vitess/go/mysql"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
@@ -23,3 +41,341 @@ provider "dolt" {
 }
 `
 }
+
+// testAccDoltServer starts an in-process dolt sql-server on a random port and returns its host and port.
+func testAccDoltServer(t *testing.T) (string, string) {
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	fs, err := filesys.LocalFS.WithWorkingDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg := config.NewMapConfig(map[string]string{
+		config.UserNameKey:  "Test Server",
+		config.UserEmailKey: "server@example.com",
+	})
+	mrEnv, err := embedded.LoadMultiEnvFromDir(ctx, cfg, fs, dir, "0.40.17")
+	if err != nil {
+		t.Fatal(err)
+	}
+	se, err := engine.NewSqlEngine(ctx, mrEnv, &engine.SqlEngineConfig{
+		ServerUser: "root",
+		ServerHost: "localhost",
+		Autocommit: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Like dolt sql-server, enable privilege checks with root as super user
+	mysqlDb := se.GetUnderlyingEngine().Analyzer.Catalog.MySQLDb
+	ed := mysqlDb.Editor()
+	mysqlDb.AddSuperUser(ed, "root", "%", "")
+	ed.Close()
+
+	sessionBuilder := func(ctx context.Context, conn *mysql.Conn, addr string) (gmssql.Session, error) {
+		baseSession, err := gmssql.BaseSessionFromConnection(ctx, conn, addr)
+		if err != nil {
+			return nil, err
+		}
+		return se.NewDoltSession(ctx, baseSession)
+	}
+	srv, err := server.NewServer(server.Config{Protocol: "tcp", Address: "127.0.0.1:0"}, se.GetUnderlyingEngine(), sessionBuilder, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	go func() {
+		_ = srv.Start()
+	}()
+	t.Cleanup(func() {
+		_ = srv.Close()
+		_ = se.Close()
+	})
+
+	host, port, err := net.SplitHostPort(srv.Listener.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return host, port
+}
+
+// testAccServerExec runs queries against a server started by testAccDoltServer, e.g. to simulate changes made out of band.
+func testAccServerExec(t *testing.T, host, port string, queries ...string) {
+	db, err := sql.Open("mysql", fmt.Sprintf("root@tcp(%s)/", net.JoinHostPort(host, port)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	conn, err := db.Conn(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	for _, query := range queries {
+		_, err := conn.ExecContext(context.Background(), query)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// testAccDoltExec runs queries against a database in the working directory, e.g. to simulate changes made out of band.
+func testAccDoltExec(t *testing.T, database string, queries ...string) {
+	dir, err := filepath.Abs(".")
+	if err != nil {
+		t.Fatal(err)
+	}
+	db, err := sql.Open("dolt", fmt.Sprintf("file://%s?commitname=Test&commitemail=test@example.com&database=%s", dir, database))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	for _, query := range queries {
+		_, err := db.ExecContext(context.Background(), query)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func testAccProviderServerConfig(host, port string) string {
+	return fmt.Sprintf(`
+provider "dolt" {
+  server {
+    host = "%s"
+    port = %s
+    user = "root"
+  }
+}
+`, host, port)
+}
+
+func testAccProviderConfigWithCommitMode(commitMode string) string {
+	return fmt.Sprintf(`
+provider "dolt" {
+  path  = "."
+  email = "test@example.com"
+  name  = "Test Example"
+
+  commit_mode = "%s"
+}
+`, commitMode)
+}
+
+func testAccProviderConfigWithCommitMessageTemplate(template string) string {
+	return fmt.Sprintf(`
+provider "dolt" {
+  path  = "."
+  email = "test@example.com"
+  name  = "Test Example"
+
+  commit_message_template = "%s"
+}
+`, template)
+}
+
+func TestAccProviderCommitMode(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfigWithCommitMode("sometimes") +
+					testAccDatabaseResourceConfig(),
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
+			{
+				Config: testAccProviderConfigWithCommitMode("per_transaction") +
+					testAccDatabaseResourceConfig() +
+					testAccTableResourceConfig() +
+					testAccRowSetResourceConfigOne(),
+				Check: testAccCheckDoltLog("test",
+					"terraform: create dolt_rowset test_table",
+					"terraform: create dolt_table test_table",
+					"Initialize data repository",
+				),
+			},
+		},
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfigWithCommitMode("none") +
+					testAccDatabaseResourceConfig() +
+					testAccTableResourceConfig() +
+					testAccRowSetResourceConfigOne(),
+				Check: testAccCheckDoltLog("test",
+					"Initialize data repository",
+				),
+			},
+		},
+	})
+}
+
+func TestAccProviderCommitMessageTemplate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfigWithCommitMessageTemplate("terraform: {{.Operation") +
+					testAccDatabaseResourceConfig(),
+				ExpectError: regexp.MustCompile("Invalid Commit Message Template"),
+			},
+			{
+				Config: testAccProviderConfigWithCommitMessageTemplate("{{.Operation}} {{.Address}} in {{.Database}}") +
+					testAccDatabaseResourceConfig() +
+					testAccTableResourceConfig() +
+					testAccRowSetResourceConfigOne(),
+				Check: testAccCheckDoltLog("test",
+					"create test_table in test",
+					"create test_table in test",
+					"Initialize data repository",
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckDoltLog checks the commit messages of the default branch of a database in the working directory,
+// starting with the most recent commit.
+func testAccCheckDoltLog(database string, messages ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		dir, err := filepath.Abs(".")
+		if err != nil {
+			return err
+		}
+		db, err := sql.Open("dolt", fmt.Sprintf("file://%s?commitname=Test&commitemail=test@example.com&database=%s", dir, database))
+		if err != nil {
+			return err
+		}
+		defer db.Close()
+
+		rows, err := db.Query("SELECT message FROM dolt_log")
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+
+		var actual []string
+		for rows.Next() {
+			var message string
+			err = rows.Scan(&message)
+			if err != nil {
+				return err
+			}
+			actual = append(actual, message)
+		}
+		if err = rows.Err(); err != nil {
+			return err
+		}
+
+		if strings.Join(actual, "\n") != strings.Join(messages, "\n") {
+			return fmt.Errorf("expected commits %q, got %q", messages, actual)
+		}
+		return nil
+	}
+}
+
+func TestAccProviderPathOrServer(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "dolt" {
+  path = "."
+
+  server {
+    host = "localhost"
+  }
+}
+` + testAccDatabaseResourceConfig(),
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+		},
+	})
+}
+
+func TestAccProviderServer(t *testing.T) {
+	host, port := testAccDoltServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderServerConfig(host, port) +
+					testAccDatabaseResourceConfig() +
+					testAccTableResourceConfig() +
+					testAccRowSetResourceConfigTwo() +
+					testAccViewResourceConfig() +
+					testAccBranchResourceConfig() +
+					testAccTagResourceConfig() +
+					testAccTableResourceOnBranchConfig() +
+					testAccExistingTableDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dolt_rowset.test", "row_count", "2"),
+					resource.TestCheckResourceAttrSet("dolt_branch.test", "head"),
+					resource.TestCheckResourceAttrSet("dolt_tag.test", "date"),
+					resource.TestCheckResourceAttr("dolt_table.branch", "columns.#", "2"),
+					resource.TestCheckResourceAttr("data.dolt_table.test", "columns.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestOpenPathSessionsSeeAddedRemotes(t *testing.T) {
+	ctx := context.Background()
+	data := DoltProviderModel{
+		Path:  types.StringValue(t.TempDir()),
+		Email: types.StringValue("test@example.com"),
+		Name:  types.StringValue("Test Example"),
+	}
+	resp := &provider.ConfigureResponse{}
+	db := (&DoltProvider{}).openPath(data, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatal(resp.Diagnostics)
+	}
+	defer db.Close()
+
+	exec := func(conn *sql.Conn, queries ...string) {
+		t.Helper()
+		for _, query := range queries {
+			if _, err := conn.ExecContext(ctx, query); err != nil {
+				t.Fatalf("%s: %s", query, err)
+			}
+		}
+	}
+	conn := func() *sql.Conn {
+		t.Helper()
+		conn, err := db.Conn(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return conn
+	}
+
+	if _, err := db.ExecContext(ctx, "CREATE DATABASE test"); err != nil {
+		t.Fatal(err)
+	}
+
+	reader, writer := conn(), conn()
+	exec(reader, "USE test", "SELECT * FROM dolt_remotes")
+	exec(writer, "USE test", fmt.Sprintf("CALL DOLT_REMOTE('add', 'origin', 'file://%s')", filepath.ToSlash(t.TempDir())))
+	// The session released last is the first one to be reused
+	_ = writer.Close()
+	_ = reader.Close()
+
+	pusher := conn()
+	defer pusher.Close()
+	exec(pusher, "USE test", "CALL DOLT_PUSH('origin', 'main')")
+}
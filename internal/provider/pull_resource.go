@@ -0,0 +1,301 @@
+package provider
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &PullResource{}
+
+func NewPullResource() resource.Resource {
+	return &PullResource{}
+}
+
+type PullResource struct {
+	client *DoltClient
+}
+
+type PullResourceModel struct {
+	Database           types.String `tfsdk:"database"`
+	Branch             types.String `tfsdk:"branch"`
+	Remote             types.String `tfsdk:"remote"`
+	Ref                types.String `tfsdk:"ref"`
+	FastForwardOnly    types.Bool   `tfsdk:"fast_forward_only"`
+	ConflictResolution types.String `tfsdk:"conflict_resolution"`
+	Triggers           types.Map    `tfsdk:"triggers"`
+	Hash               types.String `tfsdk:"hash"`
+	Conflicts          types.Int64  `tfsdk:"conflicts"`
+}
+
+func (m PullResourceModel) useQuery() string {
+	return fmt.Sprintf("USE %s", quoteIdentifier(revisionDatabase(m.Database, m.Branch)))
+}
+
+func (m PullResourceModel) commitMessage(operation string) commitMessage {
+	return commitMessage{
+		Type:      "dolt_pull",
+		Database:  m.Database.ValueString(),
+		Address:   revisionDatabase(m.Database, m.Branch),
+		Operation: operation,
+	}
+}
+
+func (m PullResourceModel) upstreamQuery() string {
+	return "SELECT branch FROM dolt_branches WHERE name = ACTIVE_BRANCH()"
+}
+
+func (m PullResourceModel) fetchQuery(ref string) (string, []any) {
+	return "CALL DOLT_FETCH(?, ?)", []any{m.Remote.ValueString(), ref}
+}
+
+func (m PullResourceModel) pullQuery(ref string) (string, []any) {
+	return "CALL DOLT_PULL(?, ?)", []any{m.Remote.ValueString(), ref}
+}
+
+func (r *PullResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pull"
+}
+
+func (r *PullResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Pull resource, fetches a branch from a remote and merges it into a branch of the database, which must not have uncommitted changes. " +
+			"The branch is pulled again whenever any of the arguments change, destroying the resource leaves the commit history untouched.",
+
+		Attributes: map[string]schema.Attribute{
+			"database": schema.StringAttribute{
+				MarkdownDescription: "Name of the database to pull into",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"branch": schema.StringAttribute{
+				MarkdownDescription: "Branch to pull into, defaults to the default branch",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"remote": schema.StringAttribute{
+				MarkdownDescription: "Name of the remote to pull from",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ref": schema.StringAttribute{
+				MarkdownDescription: "Branch of the remote to pull, defaults to the upstream of the branch",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"fast_forward_only": schema.BoolAttribute{
+				MarkdownDescription: "Whether to fail unless the branch can be fast-forwarded to the branch of the remote",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"conflict_resolution": schema.StringAttribute{
+				MarkdownDescription: "How to handle conflicts, one of `fail` (default) to leave the branch untouched, " +
+					"`ours` to keep the rows of the branch or `theirs` to take the rows of the remote. Constraint violations always fail the pull",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(conflictResolutionFail),
+				Validators: []validator.String{
+					stringvalidator.OneOf(conflictResolutionFail, conflictResolutionOurs, conflictResolutionTheirs),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values that cause the branch to be pulled again when changed",
+				ElementType:         types.StringType,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"hash": schema.StringAttribute{
+				MarkdownDescription: "Hash of the head of the branch after pulling",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"conflicts": schema.Int64Attribute{
+				MarkdownDescription: "Number of conflicting rows that were resolved according to `conflict_resolution`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *PullResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*DoltClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *DoltClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *PullResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PullResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tx, err := r.client.beginTx(ctx, data.commitMessage("create"))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to pull, got error: %s", err))
+		return
+	}
+	// A failed pull leaves conflicts in the working set of the transaction, they must not be committed
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	_, err = tx.ExecContext(ctx, data.useQuery())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to pull, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(checkWorkingSetClean(ctx, tx, revisionDatabase(data.Database, data.Branch))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ref := data.Ref.ValueString()
+	if data.Ref.IsNull() {
+		var upstream sql.NullString
+		err = tx.QueryRowContext(ctx, data.upstreamQuery()).Scan(&upstream)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read upstream, got error: %s", err))
+			return
+		}
+		if upstream.String == "" {
+			resp.Diagnostics.AddError("Missing Upstream", fmt.Sprintf("Branch %s has no upstream, set ref to the branch of the remote to pull", revisionDatabase(data.Database, data.Branch)))
+			return
+		}
+		ref = upstream.String
+	}
+	trackingBranch := fmt.Sprintf("%s/%s", data.Remote.ValueString(), ref)
+
+	if data.FastForwardOnly.ValueBool() {
+		fetchQuery, args := data.fetchQuery(ref)
+		_, err = tx.ExecContext(ctx, fetchQuery, args...)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to fetch, got error: %s", err))
+			return
+		}
+
+		var fastForward bool
+		fastForwardQuery, args := fastForwardQuery(trackingBranch)
+		err = tx.QueryRowContext(ctx, fastForwardQuery, args...).Scan(&fastForward)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to pull, got error: %s", err))
+			return
+		}
+		if !fastForward {
+			resp.Diagnostics.AddError("Cannot Fast-Forward", fmt.Sprintf("Branch %s has diverged from %s, it can only be pulled without fast_forward_only", revisionDatabase(data.Database, data.Branch), trackingBranch))
+			return
+		}
+	}
+
+	var fastForward, conflicts int64
+	var message string
+	pullQuery, args := data.pullQuery(ref)
+	err = tx.QueryRowContext(ctx, pullQuery, args...).Scan(&fastForward, &conflicts, &message)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to pull, got error: %s", err))
+		return
+	}
+
+	conflictCounts, _, diagnostics := completeMerge(ctx, tx, trackingBranch, data.ConflictResolution.ValueString(), false, fmt.Sprintf("Merge remote-tracking branch '%s'", trackingBranch))
+	resp.Diagnostics.Append(diagnostics...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var hash string
+	err = tx.QueryRowContext(ctx, "SELECT HASHOF('HEAD')").Scan(&hash)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to pull, got error: %s", err))
+		return
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to pull, got error: %s", err))
+		return
+	}
+
+	conflicts = 0
+	for _, conflict := range conflictCounts {
+		conflicts += conflict.count
+	}
+	data.Hash = types.StringValue(hash)
+	data.Conflicts = types.Int64Value(conflicts)
+
+	tflog.Trace(ctx, "pulled a branch")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PullResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PullResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PullResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data PullResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PullResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Trace(ctx, "removed a pull from state, the commit history is left untouched")
+}
@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// testAccRemoteServer starts a server with a database named golden that is pushed to a file remote
+// and a database named replica that is cloned from it. Afterwards both databases diverge.
+func testAccRemoteServer(t *testing.T) (string, string) {
+	host, port := testAccDoltServer(t)
+	remoteURL := "file://" + t.TempDir()
+	testAccServerExec(t, host, port,
+		"CREATE DATABASE golden",
+		"USE golden",
+		"CREATE TABLE test_table (id INT PRIMARY KEY, name VARCHAR(100))",
+		"INSERT INTO test_table VALUES (1, 'Alice')",
+		"CALL DOLT_COMMIT('-A', '-m', 'Add Alice')",
+		fmt.Sprintf("CALL DOLT_REMOTE('add', 'origin', '%s')", remoteURL),
+		"CALL DOLT_PUSH('origin', 'main')",
+		fmt.Sprintf("CALL DOLT_CLONE('%s', 'replica')", remoteURL),
+		"UPDATE test_table SET name = 'Alicia' WHERE id = 1",
+		"INSERT INTO test_table VALUES (2, 'Bob')",
+		"CALL DOLT_COMMIT('-A', '-m', 'Rename Alice and add Bob')",
+		"CALL DOLT_PUSH('origin', 'main')",
+		"USE replica",
+		"UPDATE test_table SET name = 'Alex' WHERE id = 1",
+		"CALL DOLT_COMMIT('-A', '-m', 'Rename Alice')",
+	)
+	return host, port
+}
+
+func TestAccPullResource(t *testing.T) {
+	host, port := testAccRemoteServer(t)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderServerConfig(host, port) +
+					testAccPullResourceConfig(`fast_forward_only = true`),
+				ExpectError: regexp.MustCompile("Cannot Fast-Forward"),
+			},
+			{
+				Config: testAccProviderServerConfig(host, port) +
+					testAccPullResourceConfig(""),
+				ExpectError: regexp.MustCompile(`conflicts in test_table \(1 rows\)`),
+			},
+			{
+				PreConfig: func() {
+					testAccServerExec(t, host, port, "USE replica", "INSERT INTO test_table VALUES (9, 'Uncommitted')")
+				},
+				Config: testAccProviderServerConfig(host, port) +
+					testAccPullResourceConfig(`conflict_resolution = "theirs"`),
+				ExpectError: regexp.MustCompile(`Branch replica has uncommitted changes in test_table`),
+			},
+			{
+				PreConfig: func() {
+					testAccServerExec(t, host, port, "USE replica", "CALL DOLT_RESET('--hard')")
+				},
+				Config: testAccProviderServerConfig(host, port) +
+					testAccPullResourceConfig(`conflict_resolution = "theirs"`) +
+					testAccPullResourceQueryConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dolt_pull.test", "conflicts", "1"),
+					resource.TestCheckResourceAttrPair("dolt_pull.test", "hash", "data.dolt_log.test", "commits.0.hash"),
+					resource.TestCheckResourceAttr("data.dolt_log.test", "commits.0.message", "Merge remote-tracking branch 'origin/main'"),
+					resource.TestCheckResourceAttr("data.dolt_log.test", "commits.0.parents.#", "2"),
+					resource.TestCheckResourceAttr("data.dolt_query.test", "rows.#", "2"),
+					resource.TestCheckResourceAttr("data.dolt_query.test", "rows.0.name", "Alicia"),
+					resource.TestCheckResourceAttr("data.dolt_query.test", "rows.1.name", "Bob"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPullResourceConfig(options string) string {
+	return fmt.Sprintf(`
+resource "dolt_pull" "test" {
+  database = "replica"
+  remote   = "origin"
+  %s
+}
+`, options)
+}
+
+func testAccPullResourceQueryConfig() string {
+	return `
+data "dolt_log" "test" {
+  database = dolt_pull.test.database
+  limit    = 1
+}
+
+data "dolt_query" "test" {
+  database = dolt_pull.test.database
+  query    = "SELECT id, name FROM test_table ORDER BY id"
+}
+`
+}
@@ -0,0 +1,222 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &PushResource{}
+
+func NewPushResource() resource.Resource {
+	return &PushResource{}
+}
+
+type PushResource struct {
+	client *DoltClient
+}
+
+type PushResourceModel struct {
+	Database    types.String `tfsdk:"database"`
+	Remote      types.String `tfsdk:"remote"`
+	Ref         types.String `tfsdk:"ref"`
+	SetUpstream types.Bool   `tfsdk:"set_upstream"`
+	Force       types.Bool   `tfsdk:"force"`
+	Triggers    types.Map    `tfsdk:"triggers"`
+	Hash        types.String `tfsdk:"hash"`
+}
+
+func (m PushResourceModel) useQuery() string {
+	return fmt.Sprintf("USE %s", quoteIdentifier(m.Database.ValueString()))
+}
+
+func (m PushResourceModel) commitMessage(operation string) commitMessage {
+	return commitMessage{
+		Type:      "dolt_push",
+		Database:  m.Database.ValueString(),
+		Address:   fmt.Sprintf("%s/%s", m.Remote.ValueString(), m.Ref.ValueString()),
+		Operation: operation,
+	}
+}
+
+func (m PushResourceModel) hashQuery() (string, []any) {
+	return "SELECT HASHOF(?)", []any{m.Ref.ValueString()}
+}
+
+func (m PushResourceModel) pushQuery() (string, []any) {
+	var args []any
+	if m.SetUpstream.ValueBool() {
+		args = append(args, "--set-upstream")
+	}
+	if m.Force.ValueBool() {
+		args = append(args, "--force")
+	}
+	args = append(args, m.Remote.ValueString(), m.Ref.ValueString())
+	return fmt.Sprintf("CALL DOLT_PUSH(%s)", placeholders(len(args))), args
+}
+
+func (r *PushResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_push"
+}
+
+func (r *PushResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Push resource, pushes a branch or tag to a remote. " +
+			"The ref is pushed again whenever any of the arguments change, destroying the resource leaves the remote untouched.",
+
+		Attributes: map[string]schema.Attribute{
+			"database": schema.StringAttribute{
+				MarkdownDescription: "Name of the database to push from",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"remote": schema.StringAttribute{
+				MarkdownDescription: "Name of the remote to push to",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ref": schema.StringAttribute{
+				MarkdownDescription: "Branch or tag to push",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"set_upstream": schema.BoolAttribute{
+				MarkdownDescription: "Whether to set the pushed branch of the remote as upstream of the branch",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"force": schema.BoolAttribute{
+				MarkdownDescription: "Whether to overwrite the ref on the remote even if the push is not a fast-forward",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values that cause the ref to be pushed again when changed",
+				ElementType:         types.StringType,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"hash": schema.StringAttribute{
+				MarkdownDescription: "Hash of the pushed commit",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *PushResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*DoltClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *DoltClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *PushResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PushResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tx, err := r.client.beginTx(ctx, data.commitMessage("create"))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to push, got error: %s", err))
+		return
+	}
+
+	_, err = tx.ExecContext(ctx, data.useQuery())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to push, got error: %s", err))
+		return
+	}
+
+	var hash string
+	hashQuery, args := data.hashQuery()
+	err = tx.QueryRowContext(ctx, hashQuery, args...).Scan(&hash)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to push, got error: %s", err))
+		return
+	}
+
+	pushQuery, args := data.pushQuery()
+	_, err = tx.ExecContext(ctx, pushQuery, args...)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to push, got error: %s", err))
+		return
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to push, got error: %s", err))
+		return
+	}
+
+	data.Hash = types.StringValue(hash)
+
+	tflog.Trace(ctx, "pushed a ref")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PushResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PushResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PushResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data PushResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PushResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Trace(ctx, "removed a push from state, the remote is left untouched")
+}
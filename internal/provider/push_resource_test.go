@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPushResource(t *testing.T) {
+	remoteURL := "file://" + t.TempDir()
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() +
+					testAccDatabaseResourceConfig() +
+					testAccTableResourceConfig() +
+					testAccRowSetResourceConfigOne() +
+					testAccRemoteResourceConfig(remoteURL) +
+					testAccPushResourceConfig("1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dolt_push.test", "set_upstream", "true"),
+					resource.TestCheckResourceAttr("dolt_push.test", "force", "false"),
+					resource.TestCheckResourceAttrPair("dolt_push.test", "hash", "data.dolt_log.test", "commits.0.hash"),
+					resource.TestCheckResourceAttrPair("dolt_push.test", "hash", "data.dolt_query.test", "rows.0.hash"),
+					resource.TestCheckResourceAttr("data.dolt_query.test", "rows.0.remote", "origin"),
+				),
+			},
+			{
+				Config: testAccProviderConfig() +
+					testAccDatabaseResourceConfig() +
+					testAccTableResourceConfig() +
+					testAccRowSetResourceConfigTwo() +
+					testAccRemoteResourceConfig(remoteURL) +
+					testAccPushResourceConfig("2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dolt_push.test", "triggers.row_count", "2"),
+					resource.TestCheckResourceAttrPair("dolt_push.test", "hash", "data.dolt_log.test", "commits.0.hash"),
+					resource.TestCheckResourceAttrPair("dolt_push.test", "hash", "data.dolt_query.test", "rows.0.hash"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPushResourceConfig(rowCount string) string {
+	return fmt.Sprintf(`
+resource "dolt_push" "test" {
+  database = dolt_remote.test.database
+
+  remote       = dolt_remote.test.name
+  ref          = "main"
+  set_upstream = true
+
+  triggers = {
+    row_count = "%s"
+    rowset    = dolt_rowset.test.id
+  }
+}
+
+data "dolt_log" "test" {
+  database = dolt_push.test.database
+  limit    = 1
+}
+
+data "dolt_query" "test" {
+  database = dolt_push.test.database
+  query    = <<EOF
+SELECT remote_branches.hash, branches.remote
+FROM dolt_remote_branches AS remote_branches
+JOIN dolt_branches AS branches ON branches.name = 'main'
+WHERE remote_branches.name = 'remotes/origin/main'
+EOF
+}
+`, rowCount)
+}
@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// quoteIdentifier quotes the name of a database, table, view or column so it can be embedded into a statement.
+// Identifiers cannot be bound as placeholders, everything else should be passed as query arguments instead.
+func quoteIdentifier(name string) string {
+	return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+}
+
+// quoteIdentifiers quotes each name and joins them into a comma separated list.
+func quoteIdentifiers(names []string) string {
+	quoted := make([]string, len(names))
+	for i, name := range names {
+		quoted[i] = quoteIdentifier(name)
+	}
+	return strings.Join(quoted, ", ")
+}
+
+// quoteString quotes a string literal for statements that don't support placeholders, e.g. column comments in DDL.
+func quoteString(value string) string {
+	return "'" + strings.NewReplacer("\\", "\\\\", "'", "''").Replace(value) + "'"
+}
+
+// qualifiedName quotes a table or view name within the given database.
+func qualifiedName(database, name string) string {
+	return quoteIdentifier(database) + "." + quoteIdentifier(name)
+}
+
+// placeholders returns a comma separated list of n placeholders.
+func placeholders(n int) string {
+	return strings.TrimSuffix(strings.Repeat("?, ", n), ", ")
+}
+
+// stringElements returns the values of a list of strings.
+func stringElements(list basetypes.ListValue) []string {
+	var values []string
+	for _, e := range list.Elements() {
+		if value, ok := e.(basetypes.StringValue); ok {
+			values = append(values, value.ValueString())
+		}
+	}
+	return values
+}
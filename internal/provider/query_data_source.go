@@ -0,0 +1,326 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"time"
+)
+
+var _ datasource.DataSource = &QueryDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &QueryDataSource{}
+
+func NewQueryDataSource() datasource.DataSource {
+	return &QueryDataSource{}
+}
+
+type QueryDataSource struct {
+	client *DoltClient
+}
+
+type QueryDataSourceModel struct {
+	Database   types.String `tfsdk:"database"`
+	Branch     types.String `tfsdk:"branch"`
+	AsOf       types.String `tfsdk:"as_of"`
+	Query      types.String `tfsdk:"query"`
+	Parameters types.List   `tfsdk:"parameters"`
+	Columns    types.List   `tfsdk:"columns"`
+	Rows       types.List   `tfsdk:"rows"`
+}
+
+var queryColumnType = basetypes.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"name": types.StringType,
+		"type": types.StringType,
+	},
+}
+
+func (m QueryDataSourceModel) useQuery() string {
+	revision := m.Branch
+	if !m.AsOf.IsNull() {
+		revision = m.AsOf
+	}
+	return fmt.Sprintf("USE %s", quoteIdentifier(revisionDatabase(m.Database, revision)))
+}
+
+// readOnly returns an error unless the query is a single SELECT, SHOW or WITH statement. Rolling back the transaction
+// of the query doesn't undo DDL or Dolt procedures, so anything else could change the database on every plan.
+func (m QueryDataSourceModel) readOnly() error {
+	statement, err := sqlparser.Parse(m.Query.ValueString())
+	if err != nil {
+		return fmt.Errorf("cannot parse query: %w", err)
+	}
+	switch statement := statement.(type) {
+	case *sqlparser.Show:
+		return nil
+	case sqlparser.SelectStatement:
+		if selectsInto(statement) {
+			return errors.New("query must not select INTO variables or files")
+		}
+		return nil
+	default:
+		return errors.New("only SELECT, SHOW and WITH queries are supported")
+	}
+}
+
+// selectsInto returns whether any select of a statement stores its result with INTO.
+func selectsInto(statement sqlparser.SelectStatement) bool {
+	switch statement := statement.(type) {
+	case *sqlparser.Select:
+		return statement.Into != nil
+	case *sqlparser.SetOp:
+		return statement.Into != nil || selectsInto(statement.Left) || selectsInto(statement.Right)
+	case *sqlparser.ParenSelect:
+		return selectsInto(statement.Select)
+	default:
+		return false
+	}
+}
+
+func (m QueryDataSourceModel) args() []any {
+	var args []any
+	for _, element := range m.Parameters.Elements() {
+		parameter, ok := element.(types.String)
+		if !ok || parameter.IsNull() {
+			args = append(args, nil)
+			continue
+		}
+		args = append(args, parameter.ValueString())
+	}
+	return args
+}
+
+func (d *QueryDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_query"
+}
+
+func (d *QueryDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Query data source, runs a read-only query in a transaction that is rolled back afterwards",
+
+		Attributes: map[string]schema.Attribute{
+			"database": schema.StringAttribute{
+				MarkdownDescription: "Name of the database to run the query in",
+				Required:            true,
+			},
+			"branch": schema.StringAttribute{
+				MarkdownDescription: "Branch of the database to run the query on, defaults to the default branch",
+				Optional:            true,
+			},
+			"as_of": schema.StringAttribute{
+				MarkdownDescription: "Branch, tag or commit hash whose data the query reads, conflicts with `branch`",
+				Optional:            true,
+			},
+			"query": schema.StringAttribute{
+				MarkdownDescription: "Query to run, a single `SELECT`, `SHOW` or `WITH` statement. Use `?` placeholders for parameters",
+				Required:            true,
+			},
+			"parameters": schema.ListAttribute{
+				MarkdownDescription: "Values bound to the placeholders of the query in order, null elements are bound as NULL",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"columns": schema.ListNestedAttribute{
+				MarkdownDescription: "Columns of the result",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the column",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Type of the column as reported by the server. The embedded driver doesn't report types, " +
+								"they are derived from the values of the column instead and empty if all values are NULL",
+							Computed: true,
+						},
+					},
+				},
+			},
+			"rows": schema.ListAttribute{
+				MarkdownDescription: "Rows of the result as maps from column names to values, NULL values are null",
+				ElementType:         types.MapType{ElemType: types.StringType},
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *QueryDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.Conflicting(path.MatchRoot("branch"), path.MatchRoot("as_of")),
+	}
+}
+
+func (d *QueryDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*DoltClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *DoltClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *QueryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data QueryDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := data.readOnly()
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("query"), "Invalid Query", fmt.Sprintf("Unable to run query, %s", err))
+		return
+	}
+
+	conn, err := d.client.db.Conn(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to run query, got error: %s", err))
+		return
+	}
+	defer conn.Close()
+
+	_, err = conn.ExecContext(ctx, data.useQuery())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to run query, got error: %s", err))
+		return
+	}
+
+	// Data sources must not change anything, so whatever the query does is discarded
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to run query, got error: %s", err))
+		return
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	result, err := tx.QueryContext(ctx, data.Query.ValueString(), data.args()...)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to run query, got error: %s", err))
+		return
+	}
+	defer result.Close()
+
+	columnNames, err := result.Columns()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read query result, got error: %s", err))
+		return
+	}
+	columnTypes, err := result.ColumnTypes()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read query result, got error: %s", err))
+		return
+	}
+	typeNames := make([]string, len(columnNames))
+	for i, columnType := range columnTypes {
+		typeNames[i] = columnType.DatabaseTypeName()
+	}
+
+	var rows []attr.Value
+	for result.Next() {
+		values := make([]any, len(columnNames))
+		pointers := make([]any, len(columnNames))
+		for i := range values {
+			pointers[i] = &values[i]
+		}
+		err := result.Scan(pointers...)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read query result, got error: %s", err))
+			return
+		}
+		row := make(map[string]attr.Value, len(columnNames))
+		for i, name := range columnNames {
+			if typeNames[i] == "" {
+				typeNames[i] = valueTypeName(values[i])
+			}
+			text, ok := rowText(values[i], typeNames[i])
+			if !ok {
+				row[name] = types.StringNull()
+				continue
+			}
+			row[name] = types.StringValue(text)
+		}
+		value, diagnostics := types.MapValue(types.StringType, row)
+		resp.Diagnostics.Append(diagnostics...)
+		rows = append(rows, value)
+	}
+	if err := result.Err(); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read query result, got error: %s", err))
+		return
+	}
+
+	var columns []attr.Value
+	for i, name := range columnNames {
+		column, diagnostics := types.ObjectValue(queryColumnType.AttrTypes, map[string]attr.Value{
+			"name": types.StringValue(name),
+			"type": types.StringValue(typeNames[i]),
+		})
+		resp.Diagnostics.Append(diagnostics...)
+		columns = append(columns, column)
+	}
+
+	columnsList, diagnostics := types.ListValue(queryColumnType, columns)
+	resp.Diagnostics.Append(diagnostics...)
+	data.Columns = columnsList
+	rowsList, diagnostics := types.ListValue(types.MapType{ElemType: types.StringType}, rows)
+	resp.Diagnostics.Append(diagnostics...)
+	data.Rows = rowsList
+
+	tflog.Trace(ctx, "read a data source")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// valueTypeName derives the type of a column from a value scanned by the embedded driver, which doesn't report column types.
+func valueTypeName(value any) string {
+	switch value.(type) {
+	case int8:
+		return "TINYINT"
+	case int16:
+		return "SMALLINT"
+	case int32:
+		return "INT"
+	case int64:
+		return "BIGINT"
+	case uint8:
+		return "UNSIGNED TINYINT"
+	case uint16:
+		return "UNSIGNED SMALLINT"
+	case uint32:
+		return "UNSIGNED INT"
+	case uint64:
+		return "UNSIGNED BIGINT"
+	case float32:
+		return "FLOAT"
+	case float64:
+		return "DOUBLE"
+	case time.Time:
+		return "DATETIME"
+	case []byte:
+		return "BLOB"
+	case string:
+		return "VARCHAR"
+	}
+	return ""
+}
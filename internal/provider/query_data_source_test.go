@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccQueryDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() +
+					testAccDatabaseResourceConfig() +
+					testAccTableResourceConfig() +
+					testAccRowSetResourceConfigTwo() +
+					testAccQueryDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.dolt_query.test", "columns.#", "3"),
+					resource.TestCheckResourceAttr("data.dolt_query.test", "columns.0.name", "id"),
+					resource.TestCheckResourceAttr("data.dolt_query.test", "columns.0.type", "INT"),
+					resource.TestCheckResourceAttr("data.dolt_query.test", "columns.1.name", "name"),
+					resource.TestCheckResourceAttr("data.dolt_query.test", "columns.2.name", "nothing"),
+					resource.TestCheckResourceAttr("data.dolt_query.test", "rows.#", "1"),
+					resource.TestCheckResourceAttr("data.dolt_query.test", "rows.0.id", "2"),
+					resource.TestCheckResourceAttr("data.dolt_query.test", "rows.0.name", "Bob"),
+					resource.TestCheckNoResourceAttr("data.dolt_query.test", "rows.0.nothing"),
+					resource.TestCheckResourceAttr("data.dolt_query.as_of", "rows.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccQueryDataSourceConfig() string {
+	return `
+data "dolt_query" "test" {
+  database = dolt_rowset.test.database
+
+  query      = "SELECT id, name, ? AS nothing FROM test_table WHERE name = ? ORDER BY id"
+  parameters = [null, "Bob"]
+}
+
+data "dolt_query" "as_of" {
+  database = dolt_rowset.test.database
+  as_of    = "main"
+
+  query = "SELECT id FROM test_table"
+}
+`
+}
+
+func TestAccQueryDataSourceConflictingRevision(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + `
+data "dolt_query" "test" {
+  database = "test"
+  branch   = "main"
+  as_of    = "main"
+  query    = "SELECT 1"
+}
+`,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+		},
+	})
+}
+
+func TestAccQueryDataSourceReadOnly(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() +
+					testAccDatabaseResourceConfig() + `
+data "dolt_query" "test" {
+  database = dolt_database.test.name
+  query    = "CALL DOLT_BRANCH('created_by_query')"
+}
+`,
+				ExpectError: regexp.MustCompile("Invalid Query"),
+			},
+			{
+				Config: testAccProviderConfig() +
+					testAccDatabaseResourceConfig() + `
+data "dolt_query" "test" {
+  database = dolt_database.test.name
+  query    = "SELECT name FROM dolt_branches WHERE name = 'created_by_query'"
+}
+`,
+				Check: resource.TestCheckResourceAttr("data.dolt_query.test", "rows.#", "0"),
+			},
+		},
+	})
+}
+
+func TestQueryDataSourceReadOnly(t *testing.T) {
+	tests := []struct {
+		query    string
+		readOnly bool
+	}{
+		{"SELECT id FROM test_table WHERE name = ?", true},
+		{"WITH named AS (SELECT name FROM test_table) SELECT * FROM named", true},
+		{"(SELECT 1) UNION (SELECT 2)", true},
+		{"SHOW TABLES", true},
+		{"SELECT 1 INTO @x", false},
+		{"SELECT 1 UNION SELECT 2 INTO @x", false},
+		{"INSERT INTO test_table VALUES (1, 'Alice')", false},
+		{"DROP TABLE test_table", false},
+		{"CREATE TABLE other (id INT PRIMARY KEY)", false},
+		{"CALL DOLT_BRANCH('other')", false},
+		{"SELECT 1; DROP TABLE test_table", false},
+	}
+	for _, test := range tests {
+		model := QueryDataSourceModel{Query: types.StringValue(test.query)}
+		if err := model.readOnly(); (err == nil) != test.readOnly {
+			t.Errorf("readOnly(%q) = %v, expected read-only %v", test.query, err, test.readOnly)
+		}
+	}
+}
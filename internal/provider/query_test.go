@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+)
+
+func TestQuoteString(t *testing.T) {
+	tests := []struct {
+		value  string
+		quoted string
+	}{
+		{"plain", `'plain'`},
+		{"it's", `'it''s'`},
+		{`C:\dir\`, `'C:\\dir\\'`},
+		{`x\' OR 1=1 -- `, `'x\\'' OR 1=1 -- '`},
+		{`\\`, `'\\\\'`},
+		{"", `''`},
+	}
+	for _, test := range tests {
+		quoted := quoteString(test.value)
+		if quoted != test.quoted {
+			t.Errorf("quoteString(%q) = %s, expected %s", test.value, quoted, test.quoted)
+			continue
+		}
+
+		statement, err := sqlparser.Parse("SELECT " + quoted)
+		if err != nil {
+			t.Errorf("quoteString(%q) = %s cannot be parsed: %s", test.value, quoted, err)
+			continue
+		}
+		exprs := statement.(*sqlparser.Select).SelectExprs
+		literal, ok := exprs[0].(*sqlparser.AliasedExpr).Expr.(*sqlparser.SQLVal)
+		if len(exprs) != 1 || !ok || string(literal.Val) != test.value {
+			t.Errorf("quoteString(%q) = %s is not parsed as a single literal of the value", test.value, quoted)
+		}
+	}
+}
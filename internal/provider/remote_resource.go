@@ -0,0 +1,316 @@
+package provider
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &RemoteResource{}
+var _ resource.ResourceWithImportState = &RemoteResource{}
+
+func NewRemoteResource() resource.Resource {
+	return &RemoteResource{}
+}
+
+type RemoteResource struct {
+	client *DoltClient
+}
+
+type RemoteResourceModel struct {
+	Database   types.String `tfsdk:"database"`
+	Name       types.String `tfsdk:"name"`
+	Url        types.String `tfsdk:"url"`
+	FetchSpecs types.List   `tfsdk:"fetch_specs"`
+}
+
+func (m RemoteResourceModel) useQuery() string {
+	return fmt.Sprintf("USE %s", quoteIdentifier(m.Database.ValueString()))
+}
+
+func (m RemoteResourceModel) commitMessage(operation string) commitMessage {
+	return commitMessage{
+		Type:      "dolt_remote",
+		Database:  m.Database.ValueString(),
+		Address:   m.Name.ValueString(),
+		Operation: operation,
+	}
+}
+
+func (m RemoteResourceModel) createQuery() (string, []any) {
+	return "CALL DOLT_REMOTE('add', ?, ?)", []any{m.Name.ValueString(), m.Url.ValueString()}
+}
+
+func (m RemoteResourceModel) readQuery() (string, []any) {
+	return "SELECT url, fetch_specs FROM dolt_remotes WHERE name = ?", []any{m.Name.ValueString()}
+}
+
+func (m RemoteResourceModel) deleteQuery() (string, []any) {
+	return "CALL DOLT_REMOTE('remove', ?)", []any{m.Name.ValueString()}
+}
+
+func (r *RemoteResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_remote"
+}
+
+func (r *RemoteResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Remote resource, configures a remote that branches can be pushed to and fetched from",
+
+		Attributes: map[string]schema.Attribute{
+			"database": schema.StringAttribute{
+				MarkdownDescription: "Name of the database to configure the remote for",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the remote, e.g. `origin`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "URL of the remote, e.g. `file:///var/lib/dolt/remotes/db` for a directory on the local file system. " +
+					"Relative `file://` URLs are resolved against the directory of the database",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"fetch_specs": schema.ListAttribute{
+				MarkdownDescription: "Refspecs used when fetching from the remote",
+				ElementType:         types.StringType,
+				Computed:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *RemoteResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*DoltClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *DoltClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *RemoteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RemoteResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tx, err := r.client.beginTx(ctx, data.commitMessage("create"))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create remote, got error: %s", err))
+		return
+	}
+
+	_, err = tx.ExecContext(ctx, data.useQuery())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create remote, got error: %s", err))
+		return
+	}
+
+	createQuery, args := data.createQuery()
+	_, err = tx.ExecContext(ctx, createQuery, args...)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create remote, got error: %s", err))
+		return
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create remote, got error: %s", err))
+		return
+	}
+
+	found, err := r.fillData(ctx, &data)
+	if err == nil && !found {
+		err = fmt.Errorf("remote %s was not created", data.Name.ValueString())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read remote, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created a remote")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RemoteResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RemoteResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	found, err := r.fillData(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read remote, got error: %s", err))
+		return
+	}
+	if !found {
+		tflog.Warn(ctx, fmt.Sprintf("Remote %s no longer exists, removing it from state", data.Name.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RemoteResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data RemoteResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RemoteResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data RemoteResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tx, err := r.client.beginTx(ctx, data.commitMessage("delete"))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete remote, got error: %s", err))
+		return
+	}
+
+	_, err = tx.ExecContext(ctx, data.useQuery())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete remote, got error: %s", err))
+		return
+	}
+
+	deleteQuery, args := data.deleteQuery()
+	_, err = tx.ExecContext(ctx, deleteQuery, args...)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete remote, got error: %s", err))
+		return
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete remote, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a remote")
+}
+
+func (r *RemoteResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	database, name, ok := strings.Cut(req.ID, "/")
+	if !ok || database == "" || name == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: database/remote. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("database"), database)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+}
+
+// fillData selects the database first, dolt_remotes cannot be qualified with a database name.
+func (r *RemoteResource) fillData(ctx context.Context, data *RemoteResourceModel) (bool, error) {
+	conn, err := r.client.db.Conn(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer conn.Close()
+
+	_, err = conn.ExecContext(ctx, data.useQuery())
+	if err != nil {
+		return false, err
+	}
+
+	var remoteURL, fetchSpecs string
+	readQuery, args := data.readQuery()
+	err = conn.QueryRowContext(ctx, readQuery, args...).Scan(&remoteURL, &fetchSpecs)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	var specs []string
+	err = json.Unmarshal([]byte(fetchSpecs), &specs)
+	if err != nil {
+		return false, fmt.Errorf("cannot parse fetch specs %s: %w", fetchSpecs, err)
+	}
+	var specValues []attr.Value
+	for _, spec := range specs {
+		specValues = append(specValues, types.StringValue(spec))
+	}
+	data.FetchSpecs = types.ListValueMust(types.StringType, specValues)
+	if data.Url.IsNull() || !sameRemoteURL(data.Url.ValueString(), remoteURL) {
+		data.Url = types.StringValue(remoteURL)
+	}
+	return true, nil
+}
+
+// sameRemoteURL reports whether a configured URL points to the remote Dolt stored,
+// which resolves relative file:// URLs against the database directory and removes trailing slashes.
+func sameRemoteURL(configured, stored string) bool {
+	if configured == stored {
+		return true
+	}
+	configuredURL, err := url.Parse(configured)
+	if err != nil {
+		return false
+	}
+	storedURL, err := url.Parse(stored)
+	if err != nil || configuredURL.Scheme != storedURL.Scheme {
+		return false
+	}
+	if configuredURL.Scheme != "file" {
+		return strings.TrimSuffix(configured, "/") == strings.TrimSuffix(stored, "/")
+	}
+	configuredPath := filepath.Clean(filepath.FromSlash(configuredURL.Host + configuredURL.Path))
+	storedPath := filepath.Clean(filepath.FromSlash(storedURL.Host + storedURL.Path))
+	if filepath.IsAbs(configuredPath) {
+		return configuredPath == storedPath
+	}
+	return strings.HasSuffix(storedPath, string(filepath.Separator)+configuredPath)
+}
@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRemoteResource(t *testing.T) {
+	remoteURL := "file://" + t.TempDir()
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() +
+					testAccDatabaseResourceConfig() +
+					testAccRemoteResourceConfig(remoteURL+"/"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dolt_remote.test", "name", "origin"),
+					resource.TestCheckResourceAttr("dolt_remote.test", "url", remoteURL+"/"),
+					resource.TestCheckResourceAttr("dolt_remote.test", "fetch_specs.#", "1"),
+					resource.TestCheckResourceAttr("dolt_remote.test", "fetch_specs.0", "refs/heads/*:refs/remotes/origin/*"),
+				),
+			},
+			{
+				ResourceName:                         "dolt_remote.test",
+				ImportState:                          true,
+				ImportStateId:                        "test/origin",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+				ImportStateVerifyIgnore:              []string{"url"},
+			},
+			{
+				Config: testAccProviderConfig() +
+					testAccDatabaseResourceConfig() +
+					testAccRemoteResourceConfig("file://./remotes/test") +
+					testAccRemoteResourceQueryConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dolt_remote.test", "url", "file://./remotes/test"),
+					resource.TestMatchResourceAttr("data.dolt_query.test", "rows.0.url", regexp.MustCompile("^file:///.+/test/remotes/test$")),
+				),
+			},
+		},
+	})
+}
+
+func testAccRemoteResourceConfig(url string) string {
+	return fmt.Sprintf(`
+resource "dolt_remote" "test" {
+  database = dolt_database.test.name
+
+  name = "origin"
+  url  = "%s"
+}
+`, url)
+}
+
+func testAccRemoteResourceQueryConfig() string {
+	return `
+data "dolt_query" "test" {
+  database   = dolt_remote.test.database
+  query      = "SELECT url FROM dolt_remotes WHERE name = ?"
+  parameters = [dolt_remote.test.name]
+}
+`
+}
+
+func TestSameRemoteURL(t *testing.T) {
+	tests := []struct {
+		configured string
+		stored     string
+		same       bool
+	}{
+		{"file:///tmp/remotes/test", "file:///tmp/remotes/test", true},
+		{"file:///tmp/remotes/test/", "file:///tmp/remotes/test", true},
+		{"file://./remotes/test", "file:///var/lib/dolt/test/remotes/test", true},
+		{"file://remotes/test", "file:///var/lib/dolt/test/remotes/test", true},
+		{"file:///tmp/remotes/other", "file:///tmp/remotes/test", false},
+		{"file://./other", "file:///var/lib/dolt/test/remotes/test", false},
+		{"https://doltremoteapi.dolthub.com/org/repo/", "https://doltremoteapi.dolthub.com/org/repo", true},
+		{"aws://[table:bucket]/repo", "file:///tmp/repo", false},
+	}
+	for _, test := range tests {
+		if same := sameRemoteURL(test.configured, test.stored); same != test.same {
+			t.Errorf("sameRemoteURL(%q, %q) = %v, expected %v", test.configured, test.stored, same, test.same)
+		}
+	}
+}
@@ -0,0 +1,16 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// revisionDatabase returns the name under which Dolt exposes the given branch of a database.
+// Without a branch the plain database name is returned, which resolves to the default branch.
+func revisionDatabase(database, branch types.String) string {
+	if branch.IsNull() || branch.ValueString() == "" {
+		return database.ValueString()
+	}
+	return fmt.Sprintf("%s/%s", database.ValueString(), branch.ValueString())
+}
@@ -3,104 +3,260 @@ package provider
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"slices"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var _ resource.Resource = &RowSetResource{}
 var _ resource.ResourceWithImportState = &RowSetResource{}
+var _ resource.ResourceWithConfigValidators = &RowSetResource{}
 
 func NewRowSetResource() resource.Resource {
 	return &RowSetResource{}
 }
 
 type RowSetResource struct {
-	db *sql.DB
+	client *DoltClient
 }
 
 type RowSetResourceModel struct {
-	Database     types.String `tfsdk:"database"`
-	Table        types.String `tfsdk:"table"`
-	UniqueColumn types.String `tfsdk:"unique_column"`
-	Columns      types.List   `tfsdk:"columns"`
-	Values       types.Map    `tfsdk:"values"`
-	RowCount     types.Int64  `tfsdk:"row_count"`
+	Id            types.String  `tfsdk:"id"`
+	Database      types.String  `tfsdk:"database"`
+	Branch        types.String  `tfsdk:"branch"`
+	Table         types.String  `tfsdk:"table"`
+	UniqueColumn  types.String  `tfsdk:"unique_column"`
+	UniqueColumns types.List    `tfsdk:"unique_columns"`
+	Columns       types.List    `tfsdk:"columns"`
+	Values        types.Map     `tfsdk:"values"`
+	Rows          types.Dynamic `tfsdk:"rows"`
+	RowCount      types.Int64   `tfsdk:"row_count"`
+}
+
+func (m RowSetResourceModel) id() string {
+	return fmt.Sprintf("%s/%s/%s", m.Database.ValueString(), m.Table.ValueString(), strings.Join(m.uniqueColumns(), ","))
 }
 
 func (m RowSetResourceModel) useQuery() string {
-	return fmt.Sprintf("USE %s", m.Database.ValueString())
+	return fmt.Sprintf("USE %s", quoteIdentifier(revisionDatabase(m.Database, m.Branch)))
+}
+
+func (m RowSetResourceModel) commitMessage(operation string) commitMessage {
+	return commitMessage{
+		Type:      "dolt_rowset",
+		Database:  m.Database.ValueString(),
+		Address:   m.Table.ValueString(),
+		Operation: operation,
+	}
+}
+
+// rowSet is the set of rows managed by the resource, independent of whether they were configured with values or rows.
+// Each row holds one value per column.
+type rowSet struct {
+	columns []string
+	rows    map[string][]attr.Value
 }
 
-func (m RowSetResourceModel) upsertQuery() string {
-	var columns []string
-	for _, c := range m.Columns.Elements() {
-		if column, ok := c.(basetypes.StringValue); ok {
-			columns = append(columns, column.ValueString())
+// rowSet collects the configured rows.
+// Objects in rows that don't set the unique columns take their values from their key.
+func (m RowSetResourceModel) rowSet() (rowSet, error) {
+	set := rowSet{rows: map[string][]attr.Value{}}
+	if m.Rows.IsNull() || m.Rows.IsUnknown() {
+		set.columns = stringElements(m.Columns)
+		for key, v := range m.Values.Elements() {
+			if values, ok := v.(basetypes.ListValue); ok {
+				set.rows[key] = values.Elements()
+			}
+		}
+		return set, nil
+	}
+
+	uniqueColumns := m.uniqueColumns()
+	rows := elementsOf(m.Rows)
+	var rowColumns, keyColumns []string
+	for i, key := range sortedKeys(rows) {
+		row := elementsOf(rows[key])
+		columns := sortedKeys(row)
+		if i == 0 {
+			rowColumns = columns
+			for _, column := range uniqueColumns {
+				if _, ok := row[column]; !ok {
+					keyColumns = append(keyColumns, column)
+				}
+			}
+			set.columns = append(keyColumns, columns...)
+		} else if strings.Join(columns, ",") != strings.Join(rowColumns, ",") {
+			return set, fmt.Errorf("all rows need to set the same columns, row %q sets %s instead of %s",
+				key, strings.Join(columns, ", "), strings.Join(rowColumns, ", "))
 		}
+		components, err := keyComponents(key, len(uniqueColumns))
+		if err != nil {
+			return set, err
+		}
+		var values []attr.Value
+		for j, column := range uniqueColumns {
+			if slices.Contains(keyColumns, column) {
+				values = append(values, types.StringValue(components[j]))
+			}
+		}
+		for _, column := range columns {
+			values = append(values, row[column])
+		}
+		set.rows[key] = values
+	}
+	return set, nil
+}
+
+// uniqueColumns returns the columns that together uniquely identify each row.
+func (m RowSetResourceModel) uniqueColumns() []string {
+	if !m.UniqueColumns.IsNull() {
+		return stringElements(m.UniqueColumns)
+	}
+	return []string{m.UniqueColumn.ValueString()}
+}
+
+// keys returns the keys of all configured rows.
+func (m RowSetResourceModel) keys() []string {
+	if m.Rows.IsNull() || m.Rows.IsUnknown() {
+		return sortedKeys(m.Values.Elements())
 	}
-	columnsString := strings.Join(columns, ", ")
+	return sortedKeys(elementsOf(m.Rows))
+}
+
+// keyCondition returns a condition that matches the rows with the given keys.
+// Keys of rows with multiple unique columns are JSON encoded tuples, matched with WHERE (a, b) IN ((?, ?), ...).
+func (m RowSetResourceModel) keyCondition(keys []string) (string, []any, error) {
+	uniqueColumns := m.uniqueColumns()
+	var args []any
+	if len(uniqueColumns) == 1 {
+		for _, key := range keys {
+			args = append(args, key)
+		}
+		return fmt.Sprintf("%s IN (%s)", quoteIdentifier(uniqueColumns[0]), placeholders(len(keys))), args, nil
+	}
+	var tuples []string
+	for _, key := range keys {
+		components, err := keyComponents(key, len(uniqueColumns))
+		if err != nil {
+			return "", nil, err
+		}
+		for _, component := range components {
+			args = append(args, component)
+		}
+		tuples = append(tuples, fmt.Sprintf("(%s)", placeholders(len(components))))
+	}
+	return fmt.Sprintf("(%s) IN (%s)", quoteIdentifiers(uniqueColumns), strings.Join(tuples, ", ")), args, nil
+}
+
+func (m RowSetResourceModel) columnTypesQuery() (string, []any) {
+	return `
+		SELECT COLUMN_NAME, DATA_TYPE
+		FROM INFORMATION_SCHEMA.COLUMNS
+		WHERE TABLE_SCHEMA = ? AND TABLE_NAME = ?
+		ORDER BY ORDINAL_POSITION`, []any{revisionDatabase(m.Database, m.Branch), m.Table.ValueString()}
+}
+
+func (m RowSetResourceModel) upsertQuery(ctx context.Context, set rowSet, dataTypes columnTypes) (string, []any, error) {
 	var multipleValues []string
-	for _, vs := range m.Values.Elements() {
-		if valuesList, ok := vs.(basetypes.ListValue); ok {
-			var values []string
-			for _, v := range valuesList.Elements() {
-				values = append(values, v.String())
+	var args []any
+	for _, key := range sortedKeys(set.rows) {
+		values := set.rows[key]
+		for i, v := range values {
+			var dataType string
+			if i < len(set.columns) {
+				dataType = dataTypes[set.columns[i]]
+			}
+			arg, err := rowArg(ctx, v, dataType)
+			if err != nil {
+				return "", nil, fmt.Errorf("invalid value in row %q: %w", key, err)
 			}
-			valuesString := fmt.Sprintf("(%s)", strings.Join(values, ", "))
-			multipleValues = append(multipleValues, valuesString)
+			args = append(args, arg)
 		}
+		multipleValues = append(multipleValues, fmt.Sprintf("(%s)", placeholders(len(values))))
 	}
 	if len(multipleValues) == 0 {
-		return ""
+		return "", nil, nil
 	}
 	multipleValuesString := strings.Join(multipleValues, ", ")
 	var updateColumns []string
-	for _, c := range m.Columns.Elements() {
-		if column, ok := c.(basetypes.StringValue); ok {
-			updateColumns = append(updateColumns, fmt.Sprintf("%s = VALUES(%s)", column.ValueString(), column.ValueString()))
-		}
+	for _, column := range set.columns {
+		updateColumns = append(updateColumns, fmt.Sprintf("%s = VALUES(%s)", quoteIdentifier(column), quoteIdentifier(column)))
 	}
 	updateColumnsString := strings.Join(updateColumns, ", ")
 	query := fmt.Sprintf(`INSERT INTO %s (%s) VALUES %s ON DUPLICATE KEY UPDATE %s;`,
-		m.Table.ValueString(), columnsString, multipleValuesString, updateColumnsString)
-	return query
+		quoteIdentifier(m.Table.ValueString()), quoteIdentifiers(set.columns), multipleValuesString, updateColumnsString)
+	return query, args, nil
 }
 
-func (m RowSetResourceModel) pruneQuery(state RowSetResourceModel) string {
-	var uniqueValues []string
-	for key := range state.Values.Elements() {
-		if _, ok := m.Values.Elements()[key]; !ok {
-			uniqueValues = append(uniqueValues, fmt.Sprintf("\"%s\"", key))
+func (m RowSetResourceModel) pruneQuery(state RowSetResourceModel) (string, []any, error) {
+	keys := map[string]bool{}
+	for _, key := range m.keys() {
+		keys[key] = true
+	}
+	var pruned []string
+	for _, key := range state.keys() {
+		if !keys[key] {
+			pruned = append(pruned, key)
 		}
 	}
-	if len(uniqueValues) == 0 {
-		return ""
+	if len(pruned) == 0 {
+		return "", nil, nil
+	}
+	condition, args, err := m.keyCondition(pruned)
+	if err != nil {
+		return "", nil, err
 	}
-	uniqueValuesString := strings.Join(uniqueValues, ", ")
-	query := fmt.Sprintf(`DELETE FROM %s WHERE %s IN (%s);`,
-		m.Table.ValueString(), m.UniqueColumn.ValueString(), uniqueValuesString)
-	return query
+	query := fmt.Sprintf(`DELETE FROM %s WHERE %s;`, quoteIdentifier(m.Table.ValueString()), condition)
+	return query, args, nil
 }
 
-func (m RowSetResourceModel) deleteQuery() string {
-	var uniqueValues []string
-	for key := range m.Values.Elements() {
-		uniqueValues = append(uniqueValues, fmt.Sprintf("\"%s\"", key))
+func (m RowSetResourceModel) deleteQuery() (string, []any, error) {
+	keys := m.keys()
+	if len(keys) == 0 {
+		return "", nil, nil
 	}
-	if len(uniqueValues) == 0 {
-		return ""
+	condition, args, err := m.keyCondition(keys)
+	if err != nil {
+		return "", nil, err
 	}
-	uniqueValuesString := strings.Join(uniqueValues, ", ")
-	query := fmt.Sprintf(`DELETE FROM %s WHERE %s IN (%s);`,
-		m.Table.ValueString(), m.UniqueColumn.ValueString(), uniqueValuesString)
-	return query
+	query := fmt.Sprintf(`DELETE FROM %s WHERE %s;`, quoteIdentifier(m.Table.ValueString()), condition)
+	return query, args, nil
+}
+
+func (m RowSetResourceModel) readQuery(set rowSet) (string, []any, error) {
+	keys := m.keys()
+	if len(keys) == 0 {
+		return "", nil, nil
+	}
+	condition, args, err := m.keyCondition(keys)
+	if err != nil {
+		return "", nil, err
+	}
+	columns := append(m.uniqueColumns(), set.columns...)
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE %s;",
+		quoteIdentifiers(columns), qualifiedName(revisionDatabase(m.Database, m.Branch), m.Table.ValueString()), condition)
+	return query, args, nil
+}
+
+func (m RowSetResourceModel) importQuery(columns []string) string {
+	return fmt.Sprintf("SELECT %s FROM %s;",
+		quoteIdentifiers(columns), qualifiedName(revisionDatabase(m.Database, m.Branch), m.Table.ValueString()))
 }
 
 func (r *RowSetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -112,27 +268,67 @@ func (r *RowSetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 		MarkdownDescription: "RowSet resource",
 
 		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Row set identifier in the format `database/table/unique_column`, with the unique columns separated by commas",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"database": schema.StringAttribute{
 				MarkdownDescription: "Name of the database that contains the row set",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"branch": schema.StringAttribute{
+				MarkdownDescription: "Branch of the database that contains the row set, defaults to the default branch",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"table": schema.StringAttribute{
 				MarkdownDescription: "Name of the table where the set of rows will be stored",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"unique_column": schema.StringAttribute{
 				MarkdownDescription: "Column that will be used to uniquely identify each row",
-				Required:            true,
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"unique_columns": schema.ListAttribute{
+				MarkdownDescription: "Columns that will be used together to uniquely identify each row, e.g. the columns of a composite primary key. " +
+					"The keys of `values` or `rows` are then JSON encoded tuples of the values of these columns, e.g. `jsonencode([1, \"EUR\"])`",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
 			},
 			"columns": schema.ListAttribute{
-				MarkdownDescription: "Columns for which values will be inserted",
+				MarkdownDescription: "Columns for which values will be inserted, required together with `values`",
 				ElementType:         types.StringType,
-				Required:            true,
+				Optional:            true,
 			},
 			"values": schema.MapAttribute{
-				MarkdownDescription: "Values to be inserted into the table",
+				MarkdownDescription: "Values to be inserted into the table, keyed by the value of the unique column, in the order of `columns`",
 				ElementType:         types.ListType{ElemType: types.StringType},
-				Required:            true,
+				Optional:            true,
+			},
+			"rows": schema.DynamicAttribute{
+				MarkdownDescription: "Rows to be inserted into the table as an object per row, keyed by the value of the unique column. " +
+					"Values keep their Terraform type and are mapped onto the column types, `null` is stored as NULL and objects or lists are stored as JSON",
+				Optional: true,
 			},
 			"row_count": schema.Int64Attribute{
 				MarkdownDescription: "Number of rows that are managed by this resource",
@@ -142,23 +338,31 @@ func (r *RowSetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 	}
 }
 
+func (r *RowSetResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(path.MatchRoot("values"), path.MatchRoot("rows")),
+		resourcevalidator.ExactlyOneOf(path.MatchRoot("unique_column"), path.MatchRoot("unique_columns")),
+		resourcevalidator.RequiredTogether(path.MatchRoot("columns"), path.MatchRoot("values")),
+	}
+}
+
 func (r *RowSetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
-	db, ok := req.ProviderData.(*sql.DB)
+	client, ok := req.ProviderData.(*DoltClient)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *sql.DB, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *DoltClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.db = db
+	r.client = client
 }
 
 func (r *RowSetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -168,7 +372,7 @@ func (r *RowSetResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	tx, err := r.db.BeginTx(ctx, nil)
+	tx, err := r.client.beginTx(ctx, data.commitMessage("create"))
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create row set, got error: %s", err))
 		return
@@ -180,9 +384,25 @@ func (r *RowSetResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	upsertQuery := data.upsertQuery()
+	set, err := data.rowSet()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create row set, got error: %s", err))
+		return
+	}
+
+	_, dataTypes, err := readColumns(ctx, tx, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create row set, got error: %s", err))
+		return
+	}
+
+	upsertQuery, args, err := data.upsertQuery(ctx, set, dataTypes)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create row set, got error: %s", err))
+		return
+	}
 	if upsertQuery != "" {
-		_, err = tx.ExecContext(ctx, upsertQuery)
+		_, err = tx.ExecContext(ctx, upsertQuery, args...)
 		if err != nil {
 			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create row set, got error: %s", err))
 			return
@@ -195,7 +415,8 @@ func (r *RowSetResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	data.RowCount = types.Int64Value(int64(len(data.Values.Elements())))
+	data.Id = types.StringValue(data.id())
+	data.RowCount = types.Int64Value(int64(len(set.rows)))
 
 	tflog.Trace(ctx, "created a row set")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -208,6 +429,12 @@ func (r *RowSetResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
+	resp.Diagnostics.Append(r.fillData(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Id = types.StringValue(data.id())
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -219,7 +446,7 @@ func (r *RowSetResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	tx, err := r.db.BeginTx(ctx, nil)
+	tx, err := r.client.beginTx(ctx, data.commitMessage("update"))
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update row set, got error: %s", err))
 		return
@@ -231,18 +458,38 @@ func (r *RowSetResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	upsertQuery := data.upsertQuery()
+	set, err := data.rowSet()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update row set, got error: %s", err))
+		return
+	}
+
+	_, dataTypes, err := readColumns(ctx, tx, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update row set, got error: %s", err))
+		return
+	}
+
+	upsertQuery, args, err := data.upsertQuery(ctx, set, dataTypes)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update row set, got error: %s", err))
+		return
+	}
 	if upsertQuery != "" {
-		_, err = tx.ExecContext(ctx, upsertQuery)
+		_, err = tx.ExecContext(ctx, upsertQuery, args...)
 		if err != nil {
 			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update row set, got error: %s", err))
 			return
 		}
 	}
 
-	pruneQuery := data.pruneQuery(state)
+	pruneQuery, args, err := data.pruneQuery(state)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update row set, got error: %s", err))
+		return
+	}
 	if pruneQuery != "" {
-		_, err = tx.ExecContext(ctx, pruneQuery)
+		_, err = tx.ExecContext(ctx, pruneQuery, args...)
 		if err != nil {
 			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update row set, got error: %s", err))
 			return
@@ -255,7 +502,8 @@ func (r *RowSetResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	data.RowCount = types.Int64Value(int64(len(data.Values.Elements())))
+	data.Id = types.StringValue(data.id())
+	data.RowCount = types.Int64Value(int64(len(set.rows)))
 
 	tflog.Trace(ctx, "updated a row set")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -268,7 +516,7 @@ func (r *RowSetResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	tx, err := r.db.BeginTx(ctx, nil)
+	tx, err := r.client.beginTx(ctx, data.commitMessage("delete"))
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete row set, got error: %s", err))
 		return
@@ -280,9 +528,13 @@ func (r *RowSetResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	deleteQuery := data.deleteQuery()
+	deleteQuery, args, err := data.deleteQuery()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete row set, got error: %s", err))
+		return
+	}
 	if deleteQuery != "" {
-		_, err = tx.ExecContext(ctx, deleteQuery)
+		_, err = tx.ExecContext(ctx, deleteQuery, args...)
 		if err != nil {
 			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete row set, got error: %s", err))
 			return
@@ -301,5 +553,285 @@ func (r *RowSetResource) Delete(ctx context.Context, req resource.DeleteRequest,
 }
 
 func (r *RowSetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	parts := strings.SplitN(req.ID, "/", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: database/table/unique_column. Got: %q", req.ID),
+		)
+		return
+	}
+
+	data := RowSetResourceModel{
+		Database:      types.StringValue(parts[0]),
+		Branch:        types.StringNull(),
+		Table:         types.StringValue(parts[1]),
+		UniqueColumn:  types.StringNull(),
+		UniqueColumns: types.ListNull(types.StringType),
+		Rows:          types.DynamicNull(),
+	}
+	uniqueColumns := strings.Split(parts[2], ",")
+	if len(uniqueColumns) == 1 {
+		data.UniqueColumn = types.StringValue(uniqueColumns[0])
+	} else {
+		list, diags := types.ListValueFrom(ctx, types.StringType, uniqueColumns)
+		resp.Diagnostics.Append(diags...)
+		data.UniqueColumns = list
+	}
+
+	resp.Diagnostics.Append(r.importData(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Id = types.StringValue(data.id())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// importData fills the columns and values of data with all rows currently stored in the table.
+func (r *RowSetResource) importData(ctx context.Context, data *RowSetResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	columns, dataTypes, err := readColumns(ctx, r.client.db, *data)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to import row set, got error: %s", err))
+		return diags
+	}
+	if len(columns) == 0 {
+		diags.AddError("Client Error", fmt.Sprintf("Cannot find table with name %s", data.Table.ValueString()))
+		return diags
+	}
+	uniqueColumns := data.uniqueColumns()
+	var uniqueIndexes []int
+	for _, column := range uniqueColumns {
+		i := slices.Index(columns, column)
+		if i < 0 {
+			diags.AddError("Client Error", fmt.Sprintf("Cannot find column %s in table %s", column, data.Table.ValueString()))
+			return diags
+		}
+		uniqueIndexes = append(uniqueIndexes, i)
+	}
+
+	result, err := r.client.db.QueryContext(ctx, data.importQuery(columns))
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to import row set, got error: %s", err))
+		return diags
+	}
+	defer result.Close()
+
+	values := map[string]attr.Value{}
+	for result.Next() {
+		row := make([]any, len(columns))
+		dest := make([]any, len(row))
+		for i := range row {
+			dest[i] = &row[i]
+		}
+		err := result.Scan(dest...)
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to import row set, got error: %s", err))
+			return diags
+		}
+		var elements []attr.Value
+		for i, column := range columns {
+			elements = append(elements, rowValue(row[i], dataTypes[column], types.StringNull()))
+		}
+		var components []any
+		for _, i := range uniqueIndexes {
+			text, _ := rowText(row[i], dataTypes[columns[i]])
+			if isNumericType(dataTypes[columns[i]]) {
+				components = append(components, json.Number(text))
+			} else {
+				components = append(components, text)
+			}
+		}
+		key := fmt.Sprint(components[0])
+		if len(components) > 1 {
+			encoded, err := json.Marshal(components)
+			if err != nil {
+				diags.AddError("Client Error", fmt.Sprintf("Unable to import row set, got error: %s", err))
+				return diags
+			}
+			key = string(encoded)
+		}
+		list, d := types.ListValue(types.StringType, elements)
+		diags.Append(d...)
+		values[key] = list
+	}
+	err = result.Err()
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to import row set, got error: %s", err))
+		return diags
+	}
+
+	columnsList, d := types.ListValueFrom(ctx, types.StringType, columns)
+	diags.Append(d...)
+	data.Columns = columnsList
+	valuesMap, d := types.MapValue(types.ListType{ElemType: types.StringType}, values)
+	diags.Append(d...)
+	data.Values = valuesMap
+	data.RowCount = types.Int64Value(int64(len(values)))
+	return diags
+}
+
+// queryer is implemented by *sql.DB, *sql.Conn and *sql.Tx.
+type queryer interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+}
+
+// readColumns reads the names of the columns of the table in order and their data types.
+// Revision databases need to have been used on q already to show up in INFORMATION_SCHEMA.
+func readColumns(ctx context.Context, q queryer, data RowSetResourceModel) ([]string, columnTypes, error) {
+	columnTypesQuery, args := data.columnTypesQuery()
+	result, err := q.QueryContext(ctx, columnTypesQuery, args...)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer result.Close()
+	var names []string
+	dataTypes := columnTypes{}
+	for result.Next() {
+		var name, dataType string
+		err := result.Scan(&name, &dataType)
+		if err != nil {
+			return nil, nil, err
+		}
+		names = append(names, name)
+		dataTypes[name] = dataType
+	}
+	return names, dataTypes, result.Err()
+}
+
+// fillData replaces the values in data with the rows currently stored in the table.
+// Rows that were changed out of band show up with their current values, deleted rows are dropped,
+// so that the next plan restores what is configured.
+func (r *RowSetResource) fillData(ctx context.Context, data *RowSetResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	set, err := data.rowSet()
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read row set, got error: %s", err))
+		return diags
+	}
+
+	readQuery, args, err := data.readQuery(set)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read row set, got error: %s", err))
+		return diags
+	}
+	if readQuery == "" {
+		data.RowCount = types.Int64Value(0)
+		return diags
+	}
+
+	conn, err := r.client.db.Conn(ctx)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read row set, got error: %s", err))
+		return diags
+	}
+	defer conn.Close()
+
+	// Revision databases only show up in INFORMATION_SCHEMA once they have been used on the connection
+	if !data.Branch.IsNull() {
+		_, err = conn.ExecContext(ctx, data.useQuery())
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to read row set, got error: %s", err))
+			return diags
+		}
+	}
+
+	_, dataTypes, err := readColumns(ctx, conn, *data)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read row set, got error: %s", err))
+		return diags
+	}
+
+	result, err := conn.QueryContext(ctx, readQuery, args...)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read row set, got error: %s", err))
+		return diags
+	}
+	defer result.Close()
+
+	uniqueColumns := data.uniqueColumns()
+	keys := map[string]string{}
+	for _, key := range data.keys() {
+		components, err := keyComponents(key, len(uniqueColumns))
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to read row set, got error: %s", err))
+			return diags
+		}
+		keys[strings.Join(components, "\x00")] = key
+	}
+
+	priorRows := elementsOf(data.Rows)
+	priorValues := data.Values.Elements()
+	values := map[string]attr.Value{}
+	for result.Next() {
+		row := make([]any, len(uniqueColumns)+len(set.columns))
+		dest := make([]any, len(row))
+		for i := range row {
+			dest[i] = &row[i]
+		}
+		err := result.Scan(dest...)
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to read row set, got error: %s", err))
+			return diags
+		}
+		components := make([]string, len(uniqueColumns))
+		for i, column := range uniqueColumns {
+			components[i], _ = rowText(row[i], dataTypes[column])
+		}
+		key, ok := keys[strings.Join(components, "\x00")]
+		if !ok {
+			continue
+		}
+		row = row[len(uniqueColumns):]
+
+		if priorRow, ok := priorRows[key]; ok {
+			prior := elementsOf(priorRow)
+			elements := map[string]attr.Value{}
+			for i, column := range set.columns {
+				if p, ok := prior[column]; ok {
+					elements[column] = rowValue(row[i], dataTypes[column], p)
+				}
+			}
+			value, d := objectOrMap(ctx, priorRow, elements)
+			diags.Append(d...)
+			values[key] = value
+			continue
+		}
+
+		var priorList []attr.Value
+		if l, ok := priorValues[key].(basetypes.ListValue); ok {
+			priorList = l.Elements()
+		}
+		var elements []attr.Value
+		for i := range set.columns {
+			var p attr.Value = types.StringNull()
+			if i < len(priorList) {
+				p = priorList[i]
+			}
+			elements = append(elements, rowValue(row[i], dataTypes[set.columns[i]], p))
+		}
+		list, d := types.ListValue(types.StringType, elements)
+		diags.Append(d...)
+		values[key] = list
+	}
+	err = result.Err()
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read row set, got error: %s", err))
+		return diags
+	}
+
+	if data.Rows.IsNull() {
+		valuesMap, d := types.MapValue(types.ListType{ElemType: types.StringType}, values)
+		diags.Append(d...)
+		data.Values = valuesMap
+	} else {
+		rows, d := objectOrMap(ctx, data.Rows, values)
+		diags.Append(d...)
+		data.Rows = types.DynamicValue(rows)
+	}
+	data.RowCount = types.Int64Value(int64(len(values)))
+	return diags
 }
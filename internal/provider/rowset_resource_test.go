@@ -1,9 +1,14 @@
 package provider
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 )
 
 func TestAccRowSetResource(t *testing.T) {
@@ -16,15 +21,46 @@ func TestAccRowSetResource(t *testing.T) {
 					testAccDatabaseResourceConfig() +
 					testAccTableResourceConfig() +
 					testAccRowSetResourceConfigOne(),
-				Check: resource.ComposeAggregateTestCheckFunc(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dolt_rowset.test", "id", "test/test_table/id"),
+				),
+			},
+			{
+				ResourceName:      "dolt_rowset.test",
+				ImportState:       true,
+				ImportStateVerify: true,
 			},
 			{
 				Config: testAccProviderConfig() +
 					testAccDatabaseResourceConfig() +
 					testAccTableResourceConfig() +
 					testAccRowSetResourceConfigTwo(),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("dolt_rowset.test", plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue("dolt_rowset.test", tfjsonpath.New("id"), knownvalue.StringExact("test/test_table/id")),
+					},
+				},
 				Check: resource.ComposeAggregateTestCheckFunc(),
 			},
+			{
+				Config: testAccProviderConfig() +
+					testAccDatabaseResourceConfig() +
+					testAccTableResourceConfig() +
+					testAccRowSetResourceConfigByName(),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("dolt_rowset.test", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dolt_rowset.test", "id", "test/test_table/name"),
+					resource.TestCheckResourceAttr("dolt_rowset.test", "row_count", "2"),
+					resource.TestCheckResourceAttr("data.dolt_query.test", "rows.#", "2"),
+					resource.TestCheckResourceAttr("data.dolt_query.test", "rows.0.name", "Alice"),
+					resource.TestCheckResourceAttr("data.dolt_query.test", "rows.1.name", "Bob"),
+				),
+			},
 			{
 				Config: testAccProviderConfig() +
 					testAccDatabaseResourceConfig() +
@@ -43,6 +79,79 @@ func TestAccRowSetResource(t *testing.T) {
 	})
 }
 
+func TestAccRowSetResourceDrift(t *testing.T) {
+	host, port := testAccDoltServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderServerConfig(host, port) +
+					testAccDatabaseResourceConfig() +
+					testAccTableResourceConfig() +
+					testAccRowSetResourceConfigTwo(),
+			},
+			{
+				PreConfig: func() {
+					testAccServerExec(t, host, port, "UPDATE test.test_table SET name = 'Mallory' WHERE id = 1")
+				},
+				Config: testAccProviderServerConfig(host, port) +
+					testAccDatabaseResourceConfig() +
+					testAccTableResourceConfig() +
+					testAccRowSetResourceConfigTwo(),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("dolt_rowset.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dolt_rowset.test", "values.1.1", "Alice"),
+				),
+			},
+			{
+				PreConfig: func() {
+					testAccServerExec(t, host, port, "DELETE FROM test.test_table WHERE id = 2")
+				},
+				Config: testAccProviderServerConfig(host, port) +
+					testAccDatabaseResourceConfig() +
+					testAccTableResourceConfig() +
+					testAccRowSetResourceConfigTwo(),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("dolt_rowset.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dolt_rowset.test", "row_count", "2"),
+				),
+			},
+		},
+	})
+}
+
+// testAccRowSetResourceConfigByName manages the rows of testAccRowSetResourceConfigTwo by name instead of id.
+func testAccRowSetResourceConfigByName() string {
+	return `
+resource "dolt_rowset" "test" {
+  database = dolt_database.test.name
+  table    = dolt_table.test.name
+
+  columns       = ["id", "name"]
+  unique_column = "name"
+  values = {
+    Alice = ["1", "Alice"],
+    Bob   = ["2", "Bob"],
+  }
+}
+
+data "dolt_query" "test" {
+  database = dolt_rowset.test.database
+  query    = "SELECT id, name FROM test_table ORDER BY id"
+}
+`
+}
+
 func testAccRowSetResourceConfigZero() string {
 	return `
 resource "dolt_rowset" "test" {
@@ -86,3 +195,302 @@ resource "dolt_rowset" "test" {
 }
 `
 }
+
+func TestAccRowSetResourceOnBranch(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() +
+					testAccDatabaseResourceConfig() +
+					testAccBranchResourceConfig() +
+					testAccTableResourceOnBranchConfig() +
+					testAccRowSetResourceOnBranchConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dolt_rowset.branch", "branch", "test_branch"),
+					resource.TestCheckResourceAttr("dolt_rowset.branch", "row_count", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRowSetResourceOnBranchConfig() string {
+	return `
+resource "dolt_rowset" "branch" {
+  database = dolt_database.test.name
+  branch   = dolt_table.branch.branch
+  table    = dolt_table.branch.name
+
+  columns       = ["id", "name"]
+  unique_column = "id"
+  values  = {
+    1 = ["1", "Alice"],
+  }
+}
+`
+}
+
+func TestAccRowSetResourceQuoting(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() +
+					testAccRowSetResourceQuotingConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dolt_rowset.quoting", "row_count", "2"),
+					resource.TestCheckResourceAttr("dolt_rowset.quoting", "values.1.1", "O'Brien"),
+					resource.TestCheckResourceAttr("dolt_rowset.quoting", "values.2.1", "\"; DROP TABLE `order`; --"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRowSetResourceQuotingConfig() string {
+	return `
+resource "dolt_database" "quoting" {
+  name = "test-quoting"
+}
+
+resource "dolt_table" "quoting" {
+  database = dolt_database.quoting.name
+
+  name  = "order"
+  query = <<EOF
+CREATE TABLE ` + "`order`" + ` (
+	id INT PRIMARY KEY,
+	name VARCHAR(100)
+);
+EOF
+}
+
+resource "dolt_rowset" "quoting" {
+  database = dolt_database.quoting.name
+  table    = dolt_table.quoting.name
+
+  columns       = ["id", "name"]
+  unique_column = "id"
+  values  = {
+    1 = ["1", "O'Brien"],
+    2 = ["2", "\"; DROP TABLE ` + "`order`" + `; --"],
+  }
+}
+`
+}
+
+func TestAccRowSetResourceRows(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() +
+					testAccDatabaseResourceConfig() +
+					testAccRowSetResourceRowsTableConfig() +
+					testAccRowSetResourceRowsConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dolt_rowset.rows", "row_count", "2"),
+					resource.TestCheckResourceAttr("dolt_rowset.rows", "rows.1.price", "1.5"),
+					resource.TestCheckResourceAttr("dolt_rowset.rows", "rows.1.active", "true"),
+					resource.TestCheckNoResourceAttr("dolt_rowset.rows", "rows.1.parent_id"),
+					resource.TestCheckResourceAttr("dolt_rowset.rows", "rows.1.data.tags.#", "2"),
+					resource.TestCheckResourceAttr("dolt_rowset.rows", "rows.2.parent_id", "1"),
+				),
+			},
+			{
+				Config: testAccProviderConfig() +
+					testAccDatabaseResourceConfig() +
+					testAccRowSetResourceRowsTableConfig() +
+					testAccRowSetResourceRowsUpdatedConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dolt_rowset.rows", "row_count", "1"),
+					resource.TestCheckResourceAttr("dolt_rowset.rows", "rows.1.price", "2.25"),
+					resource.TestCheckResourceAttr("dolt_rowset.rows", "rows.1.data", "{\"tags\":null}"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRowSetResourceRowsTableConfig() string {
+	return `
+resource "dolt_table" "typed" {
+  database = dolt_database.test.name
+
+  name  = "typed_table"
+  query = <<EOF
+CREATE TABLE typed_table (
+	id INT PRIMARY KEY,
+	parent_id INT NULL,
+	price DECIMAL(10,2),
+	active BOOLEAN,
+	data JSON
+);
+EOF
+}
+`
+}
+
+func testAccRowSetResourceRowsConfig() string {
+	return `
+resource "dolt_rowset" "rows" {
+  database = dolt_database.test.name
+  table    = dolt_table.typed.name
+
+  unique_column = "id"
+  rows = {
+    1 = {
+      parent_id = null
+      price     = 1.5
+      active    = true
+      data      = { tags = ["a", "b"] }
+    }
+    2 = {
+      parent_id = 1
+      price     = 20
+      active    = false
+      data      = jsonencode({ tags = [] })
+    }
+  }
+}
+`
+}
+
+func testAccRowSetResourceRowsUpdatedConfig() string {
+	return `
+resource "dolt_rowset" "rows" {
+  database = dolt_database.test.name
+  table    = dolt_table.typed.name
+
+  unique_column = "id"
+  rows = {
+    1 = {
+      parent_id = null
+      price     = "2.25"
+      active    = true
+      data      = jsonencode({ tags = null })
+    }
+  }
+}
+`
+}
+
+func TestAccRowSetResourceCompositeKey(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() +
+					testAccDatabaseResourceConfig() +
+					testAccRowSetResourceCompositeKeyTableConfig() +
+					testAccRowSetResourceCompositeKeyConfigTwo(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dolt_rowset.composite", "row_count", "2"),
+					resource.TestCheckResourceAttr("dolt_rowset.composite", "rows.[1,\"EUR\"].name", "Euro"),
+					resource.TestCheckResourceAttr("dolt_rowset.composite_values", "row_count", "2"),
+				),
+			},
+			{
+				Config: testAccProviderConfig() +
+					testAccDatabaseResourceConfig() +
+					testAccRowSetResourceCompositeKeyTableConfig() +
+					testAccRowSetResourceCompositeKeyConfigOne(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dolt_rowset.composite", "row_count", "1"),
+					resource.TestCheckNoResourceAttr("dolt_rowset.composite", "rows.[1,\"USD\"].name"),
+					resource.TestCheckResourceAttr("dolt_rowset.composite_values", "row_count", "1"),
+				),
+			},
+			{
+				ResourceName:  "dolt_rowset.composite_values",
+				ImportState:   true,
+				ImportStateId: "test/composite_table/tenant_id,code",
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					attributes := states[0].Attributes
+					if attributes["row_count"] != "2" {
+						return fmt.Errorf("expected 2 imported rows, got: %s", attributes["row_count"])
+					}
+					if attributes[`values.[2,"EUR"].2`] != "Euro" {
+						return fmt.Errorf("expected imported row [2,\"EUR\"] to be named Euro, got: %s", attributes[`values.[2,"EUR"].2`])
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func testAccRowSetResourceCompositeKeyTableConfig() string {
+	return `
+resource "dolt_table" "composite" {
+  database = dolt_database.test.name
+
+  name  = "composite_table"
+  query = <<EOF
+CREATE TABLE composite_table (
+	tenant_id INT,
+	code VARCHAR(3),
+	name VARCHAR(100),
+	PRIMARY KEY (tenant_id, code)
+);
+EOF
+}
+`
+}
+
+func testAccRowSetResourceCompositeKeyConfigTwo() string {
+	return `
+resource "dolt_rowset" "composite" {
+  database = dolt_database.test.name
+  table    = dolt_table.composite.name
+
+  unique_columns = ["tenant_id", "code"]
+  rows = {
+    (jsonencode([1, "EUR"])) = { name = "Euro" }
+    (jsonencode([1, "USD"])) = { name = "US Dollar" }
+  }
+}
+
+resource "dolt_rowset" "composite_values" {
+  database = dolt_database.test.name
+  table    = dolt_table.composite.name
+
+  columns        = ["tenant_id", "code", "name"]
+  unique_columns = ["tenant_id", "code"]
+  values = {
+    (jsonencode([2, "EUR"])) = ["2", "EUR", "Euro"]
+    (jsonencode([2, "USD"])) = ["2", "USD", "US Dollar"]
+  }
+}
+`
+}
+
+func testAccRowSetResourceCompositeKeyConfigOne() string {
+	return `
+resource "dolt_rowset" "composite" {
+  database = dolt_database.test.name
+  table    = dolt_table.composite.name
+
+  unique_columns = ["tenant_id", "code"]
+  rows = {
+    (jsonencode([1, "EUR"])) = { name = "Euro" }
+  }
+}
+
+resource "dolt_rowset" "composite_values" {
+  database = dolt_database.test.name
+  table    = dolt_table.composite.name
+
+  columns        = ["tenant_id", "code", "name"]
+  unique_columns = ["tenant_id", "code"]
+  values = {
+    (jsonencode([2, "EUR"])) = ["2", "EUR", "Euro"]
+  }
+}
+`
+}
@@ -0,0 +1,368 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// columnTypes maps column names to the DATA_TYPE reported by INFORMATION_SCHEMA.COLUMNS.
+type columnTypes map[string]string
+
+func isNumericType(dataType string) bool {
+	switch strings.ToLower(dataType) {
+	case "tinyint", "smallint", "mediumint", "int", "integer", "bigint", "decimal", "numeric", "float", "double", "real":
+		return true
+	}
+	return false
+}
+
+func isJSONType(dataType string) bool {
+	return strings.ToLower(dataType) == "json"
+}
+
+func isTimeType(dataType string) bool {
+	switch strings.ToLower(dataType) {
+	case "date", "datetime", "timestamp":
+		return true
+	}
+	return false
+}
+
+var timeLayouts = []string{"2006-01-02 15:04:05.999999", time.RFC3339Nano, "2006-01-02"}
+
+// unwrapDynamic returns the value wrapped by a dynamic value, or nil if the dynamic value is null or unknown.
+func unwrapDynamic(value attr.Value) attr.Value {
+	if dynamic, ok := value.(basetypes.DynamicValue); ok {
+		if dynamic.IsNull() || dynamic.IsUnknown() {
+			return nil
+		}
+		return unwrapDynamic(dynamic.UnderlyingValue())
+	}
+	return value
+}
+
+// rowArg converts a Terraform value into an argument that can be bound to a placeholder for a column of the given type.
+// Null values are bound as SQL NULL, collections and objects are encoded as JSON for JSON columns.
+func rowArg(ctx context.Context, value attr.Value, dataType string) (any, error) {
+	value = unwrapDynamic(value)
+	if value == nil || value.IsNull() || value.IsUnknown() {
+		return nil, nil
+	}
+	switch v := value.(type) {
+	case basetypes.StringValue:
+		return v.ValueString(), nil
+	case basetypes.BoolValue:
+		return v.ValueBool(), nil
+	case basetypes.NumberValue:
+		number := v.ValueBigFloat()
+		if number.IsInt() {
+			if i, accuracy := number.Int64(); accuracy == big.Exact {
+				return i, nil
+			}
+		}
+		return number.Text('f', -1), nil
+	}
+	if !isJSONType(dataType) {
+		return nil, fmt.Errorf("cannot store %s in a column of type %s", value.Type(ctx), dataType)
+	}
+	encoded, err := json.Marshal(jsonValue(value))
+	if err != nil {
+		return nil, err
+	}
+	return string(encoded), nil
+}
+
+// jsonValue converts a Terraform value into a value that can be encoded as JSON.
+func jsonValue(value attr.Value) any {
+	value = unwrapDynamic(value)
+	if value == nil || value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	switch v := value.(type) {
+	case basetypes.StringValue:
+		return v.ValueString()
+	case basetypes.BoolValue:
+		return v.ValueBool()
+	case basetypes.NumberValue:
+		return json.Number(v.ValueBigFloat().Text('g', -1))
+	case basetypes.ObjectValue:
+		return jsonObject(v.Attributes())
+	case basetypes.MapValue:
+		return jsonObject(v.Elements())
+	case basetypes.TupleValue:
+		return jsonArray(v.Elements())
+	case basetypes.ListValue:
+		return jsonArray(v.Elements())
+	case basetypes.SetValue:
+		return jsonArray(v.Elements())
+	}
+	return value.String()
+}
+
+func jsonObject(elements map[string]attr.Value) map[string]any {
+	object := make(map[string]any, len(elements))
+	for key, element := range elements {
+		object[key] = jsonValue(element)
+	}
+	return object
+}
+
+func jsonArray(elements []attr.Value) []any {
+	array := make([]any, len(elements))
+	for i, element := range elements {
+		array[i] = jsonValue(element)
+	}
+	return array
+}
+
+// rowText renders a value scanned from a table the way MySQL would print it.
+// The second return value is false for NULL.
+func rowText(raw any, dataType string) (string, bool) {
+	switch v := raw.(type) {
+	case nil:
+		return "", false
+	case []byte:
+		return string(v), true
+	case string:
+		return v, true
+	case float32:
+		return strconv.FormatFloat(float64(v), 'g', -1, 32), true
+	case float64:
+		return strconv.FormatFloat(v, 'g', -1, 64), true
+	case time.Time:
+		if strings.ToLower(dataType) == "date" {
+			return v.Format("2006-01-02"), true
+		}
+		return v.Format("2006-01-02 15:04:05.999999"), true
+	}
+	return fmt.Sprint(raw), true
+}
+
+// rowValue converts a value scanned from a column of the given type into a Terraform value.
+// The prior value determines the Terraform type of the result and is kept as is when it is
+// semantically equal to the stored value, so that e.g. 1.5 and 1.50 in a DECIMAL column or
+// differently formatted JSON documents don't show up as a difference.
+// Without a typed prior value, numeric columns become numbers and all other columns strings.
+func rowValue(raw any, dataType string, prior attr.Value) attr.Value {
+	text, ok := rowText(raw, dataType)
+	unwrapped := prior
+	if prior != nil {
+		unwrapped = unwrapDynamic(prior)
+	}
+
+	if !ok {
+		if prior != nil && prior.IsNull() {
+			return prior
+		}
+		switch unwrapped.(type) {
+		case basetypes.StringValue:
+			return types.StringNull()
+		case basetypes.NumberValue:
+			return types.NumberNull()
+		case basetypes.BoolValue:
+			return types.BoolNull()
+		}
+		return types.DynamicNull()
+	}
+
+	if unwrapped != nil && !unwrapped.IsNull() && !unwrapped.IsUnknown() && semanticallyEqual(unwrapped, text, dataType) {
+		return prior
+	}
+
+	switch unwrapped.(type) {
+	case basetypes.StringValue:
+		return types.StringValue(text)
+	case basetypes.NumberValue:
+		if number, ok := parseNumber(text); ok {
+			return types.NumberValue(number)
+		}
+		return types.StringValue(text)
+	case basetypes.BoolValue:
+		if b, ok := parseBool(text); ok {
+			return types.BoolValue(b)
+		}
+		return types.StringValue(text)
+	}
+	if isNumericType(dataType) {
+		if number, ok := parseNumber(text); ok {
+			return types.NumberValue(number)
+		}
+	}
+	return types.StringValue(text)
+}
+
+func semanticallyEqual(prior attr.Value, text string, dataType string) bool {
+	switch v := prior.(type) {
+	case basetypes.StringValue:
+		s := v.ValueString()
+		if s == text {
+			return true
+		}
+		switch {
+		case isNumericType(dataType):
+			return numbersEqual(s, text)
+		case isJSONType(dataType):
+			return jsonEqual(s, text)
+		case isTimeType(dataType):
+			return timesEqual(s, text)
+		}
+		return false
+	case basetypes.NumberValue:
+		number, ok := parseNumber(text)
+		return ok && number.Cmp(v.ValueBigFloat()) == 0
+	case basetypes.BoolValue:
+		b, ok := parseBool(text)
+		return ok && b == v.ValueBool()
+	}
+	if !isJSONType(dataType) {
+		return false
+	}
+	encoded, err := json.Marshal(jsonValue(prior))
+	if err != nil {
+		return false
+	}
+	return jsonEqual(string(encoded), text)
+}
+
+func parseNumber(text string) (*big.Float, bool) {
+	number, _, err := big.ParseFloat(text, 10, 512, big.ToNearestEven)
+	if err != nil {
+		return nil, false
+	}
+	return number, true
+}
+
+func parseBool(text string) (bool, bool) {
+	switch strings.ToLower(text) {
+	case "1", "true":
+		return true, true
+	case "0", "false":
+		return false, true
+	}
+	return false, false
+}
+
+func numbersEqual(a, b string) bool {
+	x, ok := parseNumber(a)
+	if !ok {
+		return false
+	}
+	y, ok := parseNumber(b)
+	return ok && x.Cmp(y) == 0
+}
+
+func jsonEqual(a, b string) bool {
+	var x, y any
+	if json.Unmarshal([]byte(a), &x) != nil || json.Unmarshal([]byte(b), &y) != nil {
+		return false
+	}
+	return reflect.DeepEqual(x, y)
+}
+
+func timesEqual(a, b string) bool {
+	x, ok := parseTime(a)
+	if !ok {
+		return false
+	}
+	y, ok := parseTime(b)
+	return ok && x.Equal(y)
+}
+
+func parseTime(text string) (time.Time, bool) {
+	for _, layout := range timeLayouts {
+		t, err := time.Parse(layout, text)
+		if err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// elementsOf returns the attributes of an object or the elements of a map, unwrapping dynamic values.
+func elementsOf(value attr.Value) map[string]attr.Value {
+	switch v := unwrapDynamic(value).(type) {
+	case basetypes.ObjectValue:
+		return v.Attributes()
+	case basetypes.MapValue:
+		return v.Elements()
+	}
+	return nil
+}
+
+// objectOrMap builds a map if prior was a map and all elements have the same type, otherwise an object.
+// This keeps values read from the table in the same shape as the configuration.
+func objectOrMap(ctx context.Context, prior attr.Value, elements map[string]attr.Value) (attr.Value, diag.Diagnostics) {
+	if _, ok := unwrapDynamic(prior).(basetypes.MapValue); ok {
+		var elementType attr.Type
+		for _, element := range elements {
+			if elementType == nil {
+				elementType = element.Type(ctx)
+			} else if !elementType.Equal(element.Type(ctx)) {
+				elementType = nil
+				break
+			}
+		}
+		if elementType != nil {
+			return types.MapValue(elementType, elements)
+		}
+	}
+	attrTypes := make(map[string]attr.Type, len(elements))
+	for key, element := range elements {
+		attrTypes[key] = element.Type(ctx)
+	}
+	return types.ObjectValue(attrTypes, elements)
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// keyComponents splits the key of a row into the values of its unique columns.
+// Keys of rows with a single unique column are the value itself, keys of rows with multiple
+// unique columns are JSON encoded tuples such as [1, "EUR"].
+func keyComponents(key string, n int) ([]string, error) {
+	if n == 1 {
+		return []string{key}, nil
+	}
+	decoder := json.NewDecoder(strings.NewReader(key))
+	decoder.UseNumber()
+	var tuple []any
+	err := decoder.Decode(&tuple)
+	if err != nil || len(tuple) != n {
+		return nil, fmt.Errorf("key %q needs to be a JSON encoded tuple of %d values", key, n)
+	}
+	components := make([]string, n)
+	for i, v := range tuple {
+		switch v := v.(type) {
+		case string:
+			components[i] = v
+		case json.Number:
+			components[i] = v.String()
+		case bool:
+			components[i] = "0"
+			if v {
+				components[i] = "1"
+			}
+		default:
+			return nil, fmt.Errorf("key %q needs to be a JSON encoded tuple of strings, numbers or booleans", key)
+		}
+	}
+	return components, nil
+}
@@ -0,0 +1,382 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+)
+
+// errTableReplace is returned when the difference between two CREATE TABLE statements cannot be applied with ALTER TABLE.
+var errTableReplace = errors.New("table needs to be replaced")
+
+// Column key options as set by the parser for PRIMARY KEY, UNIQUE, UNIQUE KEY and KEY on column definitions.
+const (
+	columnKeyPrimary   = sqlparser.ColumnKeyOption(1)
+	columnKeyUnique    = sqlparser.ColumnKeyOption(3)
+	columnKeyUniqueKey = sqlparser.ColumnKeyOption(4)
+	columnKey          = sqlparser.ColumnKeyOption(5)
+)
+
+// tableDefinition is the normalized content of a CREATE TABLE statement.
+// Table options are keyed by their lowercase name, the character set is always keyed as "character set".
+type tableDefinition struct {
+	columns     []tableElement
+	primaryKey  []string
+	indexes     []tableElement
+	constraints []tableElement
+	options     map[string]string
+	partition   string
+}
+
+// tableElement is a column, index or constraint. The definition can be used in ALTER TABLE statements,
+// the canonical form is used to compare elements and excludes the name.
+type tableElement struct {
+	name       string
+	kind       string
+	definition string
+	canonical  string
+}
+
+func parseTableDefinition(query string) (tableDefinition, error) {
+	var table tableDefinition
+	statement, err := sqlparser.Parse(query)
+	if err != nil {
+		return table, err
+	}
+	ddl, ok := statement.(*sqlparser.DDL)
+	if !ok || ddl.Action != sqlparser.CreateStr || ddl.TableSpec == nil {
+		return table, fmt.Errorf("expected a CREATE TABLE statement")
+	}
+	spec := ddl.TableSpec
+
+	for _, index := range spec.Indexes {
+		if index.Info.Primary {
+			for _, column := range index.Columns {
+				table.primaryKey = append(table.primaryKey, column.Column.Lowered())
+			}
+		}
+	}
+	for _, column := range spec.Columns {
+		if column.Type.KeyOpt == columnKeyPrimary {
+			table.primaryKey = append(table.primaryKey, column.Name.Lowered())
+		}
+	}
+
+	for _, column := range spec.Columns {
+		columnType := column.Type
+		name := column.Name.String()
+		switch columnType.KeyOpt {
+		case columnKeyUnique, columnKeyUniqueKey:
+			table.indexes = append(table.indexes, newTableIndex(name, "UNIQUE KEY", []string{quoteIdentifier(name)}))
+		case columnKey:
+			table.indexes = append(table.indexes, newTableIndex(name, "KEY", []string{quoteIdentifier(name)}))
+		}
+		if columnType.ForeignKeyDef != nil {
+			table.constraints = append(table.constraints, newTableConstraint("", columnType.ForeignKeyDef))
+		}
+		if columnType.Constraint != nil {
+			table.constraints = append(table.constraints, newTableConstraint(columnType.Constraint.Name, columnType.Constraint.Details))
+		}
+		columnType.KeyOpt = 0
+		columnType.ForeignKeyDef = nil
+		columnType.Constraint = nil
+		columnType.Type = strings.ToLower(columnType.Type)
+		switch columnType.Type {
+		case "bool", "boolean":
+			columnType.Type = "tinyint"
+			columnType.Length = sqlparser.NewIntVal([]byte("1"))
+		case "integer":
+			columnType.Type = "int"
+		}
+		if slices.Contains(table.primaryKey, column.Name.Lowered()) {
+			columnType.NotNull = true
+			columnType.Null = false
+		}
+		if _, ok := columnType.Default.(*sqlparser.NullVal); ok {
+			columnType.Default = nil
+		}
+		table.columns = append(table.columns, tableElement{
+			name:       column.Name.Lowered(),
+			definition: sqlparser.String(&sqlparser.ColumnDefinition{Name: column.Name, Type: columnType}),
+			canonical:  strings.ToLower(sqlparser.String(&columnType)),
+		})
+	}
+
+	for _, index := range spec.Indexes {
+		if index.Info.Primary {
+			continue
+		}
+		var columns []string
+		for _, column := range index.Columns {
+			c := quoteIdentifier(column.Column.String())
+			if column.Length != nil {
+				c += fmt.Sprintf("(%s)", sqlparser.String(column.Length))
+			}
+			columns = append(columns, c)
+		}
+		kind := "KEY"
+		switch {
+		case index.Info.Unique:
+			kind = "UNIQUE KEY"
+		case index.Info.Fulltext:
+			kind = "FULLTEXT KEY"
+		case index.Info.Spatial:
+			kind = "SPATIAL KEY"
+		}
+		name := index.Info.Name.String()
+		if name == "" && len(index.Columns) > 0 {
+			name = index.Columns[0].Column.String()
+		}
+		table.indexes = append(table.indexes, newTableIndex(name, kind, columns))
+	}
+
+	for _, constraint := range spec.Constraints {
+		table.constraints = append(table.constraints, newTableConstraint(constraint.Name, constraint.Details))
+	}
+
+	table.options = map[string]string{}
+	for _, option := range spec.TableOpts {
+		name := strings.ToLower(option.Name)
+		if name == "charset" {
+			name = "character set"
+		}
+		table.options[name] = option.Value
+	}
+
+	if spec.PartitionOpt != nil {
+		table.partition = strings.ToLower(sqlparser.String(spec.PartitionOpt))
+	}
+	return table, nil
+}
+
+func newTableIndex(name, kind string, columns []string) tableElement {
+	return tableElement{
+		name:       strings.ToLower(name),
+		kind:       kind,
+		definition: fmt.Sprintf("%s %s (%s)", kind, quoteIdentifier(name), strings.Join(columns, ", ")),
+		canonical:  strings.ToLower(fmt.Sprintf("%s (%s)", kind, strings.Join(columns, ", "))),
+	}
+}
+
+func newTableConstraint(name string, details sqlparser.ConstraintInfo) tableElement {
+	kind := "CHECK"
+	if _, ok := details.(*sqlparser.ForeignKeyDefinition); ok {
+		kind = "FOREIGN KEY"
+	}
+	canonical := details
+	if check, ok := details.(*sqlparser.CheckConstraintDefinition); ok {
+		expr := check.Expr
+		for {
+			paren, ok := expr.(*sqlparser.ParenExpr)
+			if !ok {
+				break
+			}
+			expr = paren.Expr
+		}
+		canonical = &sqlparser.CheckConstraintDefinition{Expr: expr, Enforced: check.Enforced}
+	}
+	definition := sqlparser.String(details)
+	if name != "" {
+		definition = fmt.Sprintf("CONSTRAINT %s %s", quoteIdentifier(name), definition)
+	}
+	return tableElement{
+		name:       name,
+		kind:       kind,
+		definition: definition,
+		canonical:  strings.ToLower(sqlparser.String(canonical)),
+	}
+}
+
+// alterTableStatements returns the alterations that turn the table created by oldQuery into the table created by newQuery,
+// e.g. ADD COLUMN, MODIFY COLUMN or DROP INDEX. It returns errTableReplace if the difference can only be applied
+// by replacing the table, e.g. when columns are reordered or unnamed constraints are removed.
+//
+// A column that is dropped where another one with the same definition is added is renamed with CHANGE COLUMN,
+// so that its data is kept. Other dropped and added columns are applied with DROP COLUMN and ADD COLUMN.
+func alterTableStatements(oldQuery, newQuery string) ([]string, error) {
+	oldTable, err := parseTableDefinition(oldQuery)
+	if err != nil {
+		return nil, fmt.Errorf("%w: cannot parse previous query: %s", errTableReplace, err)
+	}
+	newTable, err := parseTableDefinition(newQuery)
+	if err != nil {
+		return nil, fmt.Errorf("%w: cannot parse query: %s", errTableReplace, err)
+	}
+	if oldTable.partition != newTable.partition {
+		return nil, fmt.Errorf("%w: partitioning changed", errTableReplace)
+	}
+
+	oldColumns := map[string]tableElement{}
+	for _, column := range oldTable.columns {
+		oldColumns[column.name] = column
+	}
+	newColumns := map[string]tableElement{}
+	for _, column := range newTable.columns {
+		newColumns[column.name] = column
+	}
+	var oldOrder, newOrder []string
+	for _, column := range oldTable.columns {
+		if _, ok := newColumns[column.name]; ok {
+			oldOrder = append(oldOrder, column.name)
+		}
+	}
+	for _, column := range newTable.columns {
+		if _, ok := oldColumns[column.name]; ok {
+			newOrder = append(newOrder, column.name)
+		}
+	}
+	if strings.Join(oldOrder, ",") != strings.Join(newOrder, ",") {
+		return nil, fmt.Errorf("%w: columns were reordered", errTableReplace)
+	}
+
+	renames := renamedColumns(oldTable.columns, newTable.columns, oldColumns, newColumns)
+
+	droppedIndexes, addedIndexes := diffTableElements(oldTable.indexes, newTable.indexes)
+	droppedConstraints, addedConstraints := diffTableElements(oldTable.constraints, newTable.constraints)
+
+	var statements []string
+	for _, constraint := range droppedConstraints {
+		if constraint.name == "" {
+			return nil, fmt.Errorf("%w: cannot drop unnamed constraint %s", errTableReplace, constraint.definition)
+		}
+		statements = append(statements, fmt.Sprintf("DROP %s %s", constraint.kind, quoteIdentifier(constraint.name)))
+	}
+	for _, index := range droppedIndexes {
+		statements = append(statements, fmt.Sprintf("DROP INDEX %s", quoteIdentifier(index.name)))
+	}
+	primaryKeyChanged := strings.Join(oldTable.primaryKey, ",") != strings.Join(newTable.primaryKey, ",")
+	if primaryKeyChanged && len(oldTable.primaryKey) > 0 {
+		statements = append(statements, "DROP PRIMARY KEY")
+	}
+	for _, column := range oldTable.columns {
+		if newName, ok := renames[column.name]; ok {
+			statements = append(statements, fmt.Sprintf("CHANGE COLUMN %s %s", quoteIdentifier(column.name), newColumns[newName].definition))
+		} else if _, ok := newColumns[column.name]; !ok {
+			statements = append(statements, fmt.Sprintf("DROP COLUMN %s", quoteIdentifier(column.name)))
+		}
+	}
+	renamed := map[string]bool{}
+	for _, newName := range renames {
+		renamed[newName] = true
+	}
+	for i, column := range newTable.columns {
+		oldColumn, ok := oldColumns[column.name]
+		if renamed[column.name] {
+			continue
+		}
+		if !ok {
+			position := "FIRST"
+			if i > 0 {
+				position = "AFTER " + quoteIdentifier(newTable.columns[i-1].name)
+			}
+			statements = append(statements, fmt.Sprintf("ADD COLUMN %s %s", column.definition, position))
+		} else if oldColumn.canonical != column.canonical {
+			statements = append(statements, fmt.Sprintf("MODIFY COLUMN %s", column.definition))
+		}
+	}
+	if primaryKeyChanged && len(newTable.primaryKey) > 0 {
+		statements = append(statements, fmt.Sprintf("ADD PRIMARY KEY (%s)", quoteIdentifiers(newTable.primaryKey)))
+	}
+	for _, index := range addedIndexes {
+		statements = append(statements, fmt.Sprintf("ADD %s", index.definition))
+	}
+	for _, constraint := range addedConstraints {
+		statements = append(statements, fmt.Sprintf("ADD %s", constraint.definition))
+	}
+	statements = append(statements, tableOptionStatements(oldTable.options, newTable.options)...)
+	return statements, nil
+}
+
+// diffTableElements matches elements by their canonical form and returns the ones that only exist on either side.
+// Elements without a name on either side match an element with the same canonical form regardless of its name.
+func diffTableElements(oldElements, newElements []tableElement) ([]tableElement, []tableElement) {
+	matched := make([]bool, len(oldElements))
+	var added []tableElement
+	for _, element := range newElements {
+		found := false
+		for i, oldElement := range oldElements {
+			if matched[i] || oldElement.kind != element.kind || oldElement.canonical != element.canonical {
+				continue
+			}
+			if oldElement.name != "" && element.name != "" && !strings.EqualFold(oldElement.name, element.name) {
+				continue
+			}
+			matched[i] = true
+			found = true
+			break
+		}
+		if !found {
+			added = append(added, element)
+		}
+	}
+	var dropped []tableElement
+	for i, oldElement := range oldElements {
+		if !matched[i] {
+			dropped = append(dropped, oldElement)
+		}
+	}
+	return dropped, added
+}
+
+// tableOptionStatements returns the table options of newOptions that are set to different values in oldOptions.
+// Options that are only set in one of them are left as they are because SHOW CREATE TABLE adds defaults such as the engine,
+// except for the comment which is only shown if it is set. The auto increment counter is never changed.
+func tableOptionStatements(oldOptions, newOptions map[string]string) []string {
+	names := []string{"comment"}
+	for name := range newOptions {
+		if _, ok := oldOptions[name]; ok && name != "comment" && name != "auto_increment" {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	var statements []string
+	for _, name := range names {
+		switch {
+		case name == "comment" && oldOptions[name] != newOptions[name]:
+			statements = append(statements, fmt.Sprintf("COMMENT = %s", quoteString(newOptions[name])))
+		case name != "comment" && !strings.EqualFold(oldOptions[name], newOptions[name]):
+			statements = append(statements, fmt.Sprintf("%s = %s", strings.ToUpper(name), newOptions[name]))
+		}
+	}
+	return statements
+}
+
+// renamedColumns returns the new names of the old columns that were renamed, keyed by the old name.
+// Columns are compared between the remaining columns around which they were dropped or added:
+// if the same number of columns was dropped and added there, a dropped column was renamed
+// if the added column at the same position has the same definition.
+func renamedColumns(oldColumns, newColumns []tableElement, oldByName, newByName map[string]tableElement) map[string]string {
+	dropped := columnGaps(oldColumns, newByName)
+	added := columnGaps(newColumns, oldByName)
+	renames := map[string]string{}
+	for after, droppedColumns := range dropped {
+		addedColumns := added[after]
+		if len(addedColumns) != len(droppedColumns) {
+			continue
+		}
+		for i, column := range droppedColumns {
+			if column.canonical == addedColumns[i].canonical {
+				renames[column.name] = addedColumns[i].name
+			}
+		}
+	}
+	return renames
+}
+
+// columnGaps groups the columns that don't exist in others by the name of the preceding column that does.
+// Columns before the first one that exists in others are grouped under the empty name.
+func columnGaps(columns []tableElement, others map[string]tableElement) map[string][]tableElement {
+	gaps := map[string][]tableElement{}
+	after := ""
+	for _, column := range columns {
+		if _, ok := others[column.name]; ok {
+			after = column.name
+			continue
+		}
+		gaps[after] = append(gaps[after], column)
+	}
+	return gaps
+}
@@ -0,0 +1,158 @@
+package provider
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestAlterTableStatements(t *testing.T) {
+	tests := []struct {
+		name       string
+		oldQuery   string
+		newQuery   string
+		statements []string
+		replace    bool
+	}{
+		{
+			name:     "unchanged",
+			oldQuery: "CREATE TABLE t (id INT PRIMARY KEY, name VARCHAR(100))",
+			newQuery: "create table t (\n\tid int primary key,\n\tname varchar(100)\n);",
+		},
+		{
+			name:     "show create table output",
+			oldQuery: "CREATE TABLE t (id INT PRIMARY KEY, active BOOLEAN, email VARCHAR(100) UNIQUE)",
+			newQuery: "CREATE TABLE `t` (\n  `id` int NOT NULL,\n  `active` tinyint(1) DEFAULT NULL,\n  `email` varchar(100),\n  PRIMARY KEY (`id`),\n  UNIQUE KEY `email` (`email`)\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_bin",
+		},
+		{
+			name:       "add nullable column",
+			oldQuery:   "CREATE TABLE t (id INT PRIMARY KEY, name VARCHAR(100))",
+			newQuery:   "CREATE TABLE t (id INT PRIMARY KEY, name VARCHAR(100), email VARCHAR(100))",
+			statements: []string{"ADD COLUMN email varchar(100) AFTER `name`"},
+		},
+		{
+			name:       "add first column",
+			oldQuery:   "CREATE TABLE t (id INT PRIMARY KEY)",
+			newQuery:   "CREATE TABLE t (tenant INT, id INT PRIMARY KEY)",
+			statements: []string{"ADD COLUMN tenant int FIRST"},
+		},
+		{
+			name:       "modify and drop columns",
+			oldQuery:   "CREATE TABLE t (id INT PRIMARY KEY, name VARCHAR(100), legacy INT)",
+			newQuery:   "CREATE TABLE t (id INT PRIMARY KEY, name VARCHAR(200) NOT NULL DEFAULT '')",
+			statements: []string{"DROP COLUMN `legacy`", "MODIFY COLUMN `name` varchar(200) not null default ''"},
+		},
+		{
+			name:       "indexes",
+			oldQuery:   "CREATE TABLE t (id INT PRIMARY KEY, name VARCHAR(100), email VARCHAR(100), INDEX idx_name (name))",
+			newQuery:   "CREATE TABLE t (id INT PRIMARY KEY, name VARCHAR(100), email VARCHAR(100), UNIQUE INDEX (email))",
+			statements: []string{"DROP INDEX `idx_name`", "ADD UNIQUE KEY `email` (`email`)"},
+		},
+		{
+			name:       "primary key",
+			oldQuery:   "CREATE TABLE t (tenant INT NOT NULL, id INT PRIMARY KEY)",
+			newQuery:   "CREATE TABLE t (tenant INT NOT NULL, id INT NOT NULL, PRIMARY KEY (tenant, id))",
+			statements: []string{"DROP PRIMARY KEY", "ADD PRIMARY KEY (`tenant`, `id`)"},
+		},
+		{
+			name:       "constraints",
+			oldQuery:   "CREATE TABLE t (id INT PRIMARY KEY, parent INT, CONSTRAINT chk_parent CHECK (parent > 0))",
+			newQuery:   "CREATE TABLE t (id INT PRIMARY KEY, parent INT, CONSTRAINT fk_parent FOREIGN KEY (parent) REFERENCES t (id))",
+			statements: []string{"DROP CHECK `chk_parent`", "ADD CONSTRAINT `fk_parent` foreign key (parent) references t (id)"},
+		},
+		{
+			name:       "rename column",
+			oldQuery:   "CREATE TABLE t (id INT PRIMARY KEY, name VARCHAR(100), email VARCHAR(100))",
+			newQuery:   "CREATE TABLE t (id INT PRIMARY KEY, full_name VARCHAR(100), email VARCHAR(100))",
+			statements: []string{"CHANGE COLUMN `name` full_name varchar(100)"},
+		},
+		{
+			name:       "rename primary key column",
+			oldQuery:   "CREATE TABLE t (id INT PRIMARY KEY, name VARCHAR(100))",
+			newQuery:   "CREATE TABLE t (user_id INT PRIMARY KEY, name VARCHAR(100))",
+			statements: []string{"DROP PRIMARY KEY", "CHANGE COLUMN `id` user_id int not null", "ADD PRIMARY KEY (`user_id`)"},
+		},
+		{
+			name:       "drop and add columns at different positions",
+			oldQuery:   "CREATE TABLE t (id INT PRIMARY KEY, legacy INT, name VARCHAR(100))",
+			newQuery:   "CREATE TABLE t (id INT PRIMARY KEY, name VARCHAR(100), email VARCHAR(100))",
+			statements: []string{"DROP COLUMN `legacy`", "ADD COLUMN email varchar(100) AFTER `name`"},
+		},
+		{
+			name:     "table options",
+			oldQuery: "CREATE TABLE t (id INT PRIMARY KEY) ENGINE=InnoDB COMMENT='users' AUTO_INCREMENT=5",
+			newQuery: "CREATE TABLE t (id INT PRIMARY KEY) engine = innodb comment 'users'",
+		},
+		{
+			name:       "column replaced with a different definition",
+			oldQuery:   "CREATE TABLE t (id INT PRIMARY KEY, name VARCHAR(100))",
+			newQuery:   "CREATE TABLE t (id INT PRIMARY KEY, full_name VARCHAR(200))",
+			statements: []string{"DROP COLUMN `name`", "ADD COLUMN full_name varchar(200) AFTER `id`"},
+		},
+		{
+			name:       "column replaced with several columns",
+			oldQuery:   "CREATE TABLE t (id INT PRIMARY KEY, name VARCHAR(100))",
+			newQuery:   "CREATE TABLE t (id INT PRIMARY KEY, first_name VARCHAR(100), last_name VARCHAR(100))",
+			statements: []string{"DROP COLUMN `name`", "ADD COLUMN first_name varchar(100) AFTER `id`", "ADD COLUMN last_name varchar(100) AFTER `first_name`"},
+		},
+		{
+			name:       "comment added",
+			oldQuery:   "CREATE TABLE t (id INT PRIMARY KEY)",
+			newQuery:   "CREATE TABLE t (id INT PRIMARY KEY) COMMENT='Users'",
+			statements: []string{"COMMENT = 'Users'"},
+		},
+		{
+			name:       "comment removed",
+			oldQuery:   "CREATE TABLE t (id INT PRIMARY KEY) ENGINE=InnoDB COMMENT='users'",
+			newQuery:   "CREATE TABLE t (id INT PRIMARY KEY)",
+			statements: []string{"COMMENT = ''"},
+		},
+		{
+			name:       "engine and comment changed",
+			oldQuery:   "CREATE TABLE t (id INT PRIMARY KEY) ENGINE=InnoDB COMMENT='users'",
+			newQuery:   "CREATE TABLE t (id INT PRIMARY KEY) ENGINE=MyISAM COMMENT='user''s'",
+			statements: []string{"COMMENT = 'user''s'", "ENGINE = MyISAM"},
+		},
+		{
+			name:       "character set changed",
+			oldQuery:   "CREATE TABLE t (id INT PRIMARY KEY) DEFAULT CHARSET=utf8mb4",
+			newQuery:   "CREATE TABLE t (id INT PRIMARY KEY) CHARSET=latin1 ENGINE=InnoDB",
+			statements: []string{"CHARACTER SET = latin1"},
+		},
+		{
+			name:     "reordered columns",
+			oldQuery: "CREATE TABLE t (id INT PRIMARY KEY, a INT, b INT)",
+			newQuery: "CREATE TABLE t (id INT PRIMARY KEY, b INT, a INT)",
+			replace:  true,
+		},
+		{
+			name:     "unnamed constraint removed",
+			oldQuery: "CREATE TABLE t (id INT PRIMARY KEY, parent INT, CHECK (parent > 0))",
+			newQuery: "CREATE TABLE t (id INT PRIMARY KEY, parent INT)",
+			replace:  true,
+		},
+		{
+			name:     "invalid query",
+			oldQuery: "CREATE TABLE t (id INT PRIMARY KEY)",
+			newQuery: "CREATE TABLE t (",
+			replace:  true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			statements, err := alterTableStatements(test.oldQuery, test.newQuery)
+			if test.replace {
+				if !errors.Is(err, errTableReplace) {
+					t.Fatalf("expected replacement, got statements %q and error %v", statements, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !reflect.DeepEqual(statements, test.statements) {
+				t.Errorf("expected statements %q, got %q", test.statements, statements)
+			}
+		})
+	}
+}
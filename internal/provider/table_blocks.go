@@ -0,0 +1,327 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type TableColumnModel struct {
+	Name          types.String `tfsdk:"name"`
+	Type          types.String `tfsdk:"type"`
+	Nullable      types.Bool   `tfsdk:"nullable"`
+	Default       types.String `tfsdk:"default"`
+	Comment       types.String `tfsdk:"comment"`
+	AutoIncrement types.Bool   `tfsdk:"auto_increment"`
+}
+
+type TablePrimaryKeyModel struct {
+	Columns types.List `tfsdk:"columns"`
+}
+
+type TableIndexModel struct {
+	Name    types.String `tfsdk:"name"`
+	Columns types.List   `tfsdk:"columns"`
+	Unique  types.Bool   `tfsdk:"unique"`
+}
+
+type TableForeignKeyModel struct {
+	Name              types.String `tfsdk:"name"`
+	Columns           types.List   `tfsdk:"columns"`
+	ReferencedTable   types.String `tfsdk:"referenced_table"`
+	ReferencedColumns types.List   `tfsdk:"referenced_columns"`
+	OnDelete          types.String `tfsdk:"on_delete"`
+	OnUpdate          types.String `tfsdk:"on_update"`
+}
+
+var referentialActions = []string{"RESTRICT", "CASCADE", "SET NULL", "SET DEFAULT", "NO ACTION"}
+
+func tableBlocks() map[string]schema.Block {
+	return map[string]schema.Block{
+		"column": schema.ListNestedBlock{
+			MarkdownDescription: "Column of the table, used to generate the query instead of configuring it directly",
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						MarkdownDescription: "Name of the column",
+						Required:            true,
+					},
+					"type": schema.StringAttribute{
+						MarkdownDescription: "Type of the column, e.g. `INT` or `VARCHAR(100)`",
+						Required:            true,
+					},
+					"nullable": schema.BoolAttribute{
+						MarkdownDescription: "Whether the column accepts NULL, defaults to true",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(true),
+					},
+					"default": schema.StringAttribute{
+						MarkdownDescription: "SQL expression used as default value of the column, e.g. `0`, `'draft'` or `(CURRENT_TIMESTAMP)`",
+						Optional:            true,
+					},
+					"comment": schema.StringAttribute{
+						MarkdownDescription: "Comment of the column",
+						Optional:            true,
+					},
+					"auto_increment": schema.BoolAttribute{
+						MarkdownDescription: "Whether the column is an auto increment column, defaults to false",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+				},
+			},
+		},
+		"primary_key": schema.SingleNestedBlock{
+			MarkdownDescription: "Primary key of the table",
+			Attributes: map[string]schema.Attribute{
+				"columns": schema.ListAttribute{
+					MarkdownDescription: "Columns of the primary key",
+					ElementType:         types.StringType,
+					Optional:            true,
+					Validators: []validator.List{
+						listvalidator.SizeAtLeast(1),
+					},
+				},
+			},
+		},
+		"index": schema.ListNestedBlock{
+			MarkdownDescription: "Index of the table",
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						MarkdownDescription: "Name of the index",
+						Required:            true,
+					},
+					"columns": schema.ListAttribute{
+						MarkdownDescription: "Columns of the index",
+						ElementType:         types.StringType,
+						Required:            true,
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+						},
+					},
+					"unique": schema.BoolAttribute{
+						MarkdownDescription: "Whether the index is a unique index, defaults to false",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+				},
+			},
+		},
+		"foreign_key": schema.ListNestedBlock{
+			MarkdownDescription: "Foreign key of the table",
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						MarkdownDescription: "Name of the foreign key constraint",
+						Required:            true,
+					},
+					"columns": schema.ListAttribute{
+						MarkdownDescription: "Columns of the table that reference the other table",
+						ElementType:         types.StringType,
+						Required:            true,
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+						},
+					},
+					"referenced_table": schema.StringAttribute{
+						MarkdownDescription: "Name of the referenced table",
+						Required:            true,
+					},
+					"referenced_columns": schema.ListAttribute{
+						MarkdownDescription: "Columns of the referenced table",
+						ElementType:         types.StringType,
+						Required:            true,
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+						},
+					},
+					"on_delete": schema.StringAttribute{
+						MarkdownDescription: "Action when a referenced row is deleted, one of `RESTRICT`, `CASCADE`, `SET NULL`, `SET DEFAULT` or `NO ACTION`",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(referentialActions...),
+						},
+					},
+					"on_update": schema.StringAttribute{
+						MarkdownDescription: "Action when a referenced row is updated, one of `RESTRICT`, `CASCADE`, `SET NULL`, `SET DEFAULT` or `NO ACTION`",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(referentialActions...),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// hasBlocks reports whether the table is configured with blocks instead of a query.
+func (m TableResourceModel) hasBlocks() bool {
+	return len(m.Column) > 0 || m.PrimaryKey != nil || len(m.Index) > 0 || len(m.ForeignKey) > 0
+}
+
+// blocksQuery generates the CREATE TABLE statement for the column, primary_key, index and foreign_key blocks.
+// The second return value is false if the statement depends on values that are not known yet.
+func (m TableResourceModel) blocksQuery() (string, bool) {
+	var definitions []string
+	for _, column := range m.Column {
+		if !allKnown(column.Name, column.Type, column.Nullable, column.Default, column.Comment, column.AutoIncrement) {
+			return "", false
+		}
+		definition := fmt.Sprintf("%s %s", quoteIdentifier(column.Name.ValueString()), column.Type.ValueString())
+		if !column.Nullable.IsNull() && !column.Nullable.ValueBool() {
+			definition += " NOT NULL"
+		}
+		if !column.Default.IsNull() {
+			definition += " DEFAULT " + column.Default.ValueString()
+		}
+		if column.AutoIncrement.ValueBool() {
+			definition += " AUTO_INCREMENT"
+		}
+		if !column.Comment.IsNull() {
+			definition += " COMMENT " + quoteString(column.Comment.ValueString())
+		}
+		definitions = append(definitions, definition)
+	}
+	if m.PrimaryKey != nil && !m.PrimaryKey.Columns.IsNull() {
+		if !allKnown(m.PrimaryKey.Columns) {
+			return "", false
+		}
+		definitions = append(definitions, fmt.Sprintf("PRIMARY KEY (%s)", quoteIdentifiers(stringElements(m.PrimaryKey.Columns))))
+	}
+	for _, index := range m.Index {
+		if !allKnown(index.Name, index.Columns, index.Unique) {
+			return "", false
+		}
+		kind := "KEY"
+		if index.Unique.ValueBool() {
+			kind = "UNIQUE KEY"
+		}
+		definitions = append(definitions, fmt.Sprintf("%s %s (%s)", kind, quoteIdentifier(index.Name.ValueString()), quoteIdentifiers(stringElements(index.Columns))))
+	}
+	for _, foreignKey := range m.ForeignKey {
+		if !allKnown(foreignKey.Name, foreignKey.Columns, foreignKey.ReferencedTable, foreignKey.ReferencedColumns, foreignKey.OnDelete, foreignKey.OnUpdate) {
+			return "", false
+		}
+		definition := fmt.Sprintf("CONSTRAINT %s FOREIGN KEY (%s) REFERENCES %s (%s)",
+			quoteIdentifier(foreignKey.Name.ValueString()),
+			quoteIdentifiers(stringElements(foreignKey.Columns)),
+			quoteIdentifier(foreignKey.ReferencedTable.ValueString()),
+			quoteIdentifiers(stringElements(foreignKey.ReferencedColumns)),
+		)
+		if !foreignKey.OnDelete.IsNull() {
+			definition += " ON DELETE " + foreignKey.OnDelete.ValueString()
+		}
+		if !foreignKey.OnUpdate.IsNull() {
+			definition += " ON UPDATE " + foreignKey.OnUpdate.ValueString()
+		}
+		definitions = append(definitions, definition)
+	}
+	if m.Name.IsUnknown() {
+		return "", false
+	}
+	return fmt.Sprintf("CREATE TABLE %s (\n  %s\n);", quoteIdentifier(m.Name.ValueString()), strings.Join(definitions, ",\n  ")), true
+}
+
+func allKnown(values ...attr.Value) bool {
+	for _, value := range values {
+		if value.IsUnknown() {
+			return false
+		}
+		if list, ok := value.(types.List); ok {
+			for _, element := range list.Elements() {
+				if element.IsUnknown() {
+					return false
+				}
+			}
+		}
+	}
+	return true
+}
+
+// validateBlocks checks that the table is configured either with a query or with blocks.
+func validateBlocks(config TableResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if config.Query.IsUnknown() {
+		return diags
+	}
+	if config.Query.IsNull() && len(config.Column) == 0 {
+		diags.AddAttributeError(
+			path.Root("query"),
+			"Missing Table Definition",
+			"Either query or at least one column block needs to be configured.",
+		)
+	}
+	if !config.Query.IsNull() && config.hasBlocks() {
+		diags.AddAttributeError(
+			path.Root("query"),
+			"Conflicting Table Definition",
+			"The column, primary_key, index and foreign_key blocks cannot be combined with query.",
+		)
+	}
+	return diags
+}
+
+// checkColumns compares the columns read from the table with the column blocks and warns about differences,
+// e.g. if the table was changed outside of Terraform.
+func (m TableResourceModel) checkColumns() diag.Diagnostics {
+	var diags diag.Diagnostics
+	if len(m.Column) == 0 {
+		return diags
+	}
+	actual := map[string]string{}
+	for _, element := range m.Columns.Elements() {
+		attributes := element.(types.Object).Attributes()
+		name := strings.ToLower(attributes["name"].(types.String).ValueString())
+		actual[name] = attributes["type"].(types.String).ValueString()
+	}
+	configured := map[string]bool{}
+	for _, column := range m.Column {
+		name := column.Name.ValueString()
+		configured[strings.ToLower(name)] = true
+		typ, ok := actual[strings.ToLower(name)]
+		if !ok {
+			diags.AddWarning("Column Mismatch", fmt.Sprintf("Column %s of table %s is configured but does not exist.", name, m.Name.ValueString()))
+			continue
+		}
+		if baseColumnType(typ) != baseColumnType(column.Type.ValueString()) {
+			diags.AddWarning("Column Mismatch", fmt.Sprintf("Column %s of table %s is configured with type %s but has type %s.", name, m.Name.ValueString(), column.Type.ValueString(), typ))
+		}
+	}
+	for _, name := range sortedKeys(actual) {
+		if !configured[name] {
+			diags.AddWarning("Column Mismatch", fmt.Sprintf("Column %s of table %s exists but is not configured.", name, m.Name.ValueString()))
+		}
+	}
+	return diags
+}
+
+// baseColumnType returns the lowercase name of a column type without length, precision or attributes, resolving aliases.
+func baseColumnType(typ string) string {
+	base := strings.ToLower(strings.TrimSpace(typ))
+	if i := strings.IndexAny(base, "( "); i >= 0 {
+		base = base[:i]
+	}
+	switch base {
+	case "bool", "boolean":
+		return "tinyint"
+	case "integer":
+		return "int"
+	case "dec", "numeric", "fixed":
+		return "decimal"
+	}
+	return base
+}
@@ -2,14 +2,12 @@ package provider
 
 import (
 	"context"
-	"database/sql"
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"maps"
 )
 
 var _ datasource.DataSource = &TableDataSource{}
@@ -19,29 +17,24 @@ func NewTableDataSource() datasource.DataSource {
 }
 
 type TableDataSource struct {
-	db *sql.DB
+	client *DoltClient
 }
 
 type TableDataSourceModel struct {
 	Database types.String `tfsdk:"database"`
+	Branch   types.String `tfsdk:"branch"`
 	Name     types.String `tfsdk:"name"`
 	Columns  types.List   `tfsdk:"columns"`
-}
 
-var columnType = basetypes.ObjectType{
-	AttrTypes: map[string]attr.Type{
-		"name": types.StringType,
-		"type": types.StringType,
-		"key":  types.StringType,
-	},
+	Indexes          types.List   `tfsdk:"indexes"`
+	ForeignKeys      types.List   `tfsdk:"foreign_keys"`
+	CheckConstraints types.List   `tfsdk:"check_constraints"`
+	RowCount         types.Int64  `tfsdk:"row_count"`
+	Ddl              types.String `tfsdk:"ddl"`
 }
 
-func (m TableDataSourceModel) readQuery() string {
-	return fmt.Sprintf(`
-		SELECT COLUMN_NAME, COLUMN_TYPE, COLUMN_KEY
-		FROM INFORMATION_SCHEMA.COLUMNS
-		WHERE `+"`"+`TABLE_SCHEMA`+"`"+` = '%s' AND `+"`"+`TABLE_NAME`+"`"+` = '%s'
-		ORDER BY ORDINAL_POSITION`, m.Database.ValueString(), m.Name.ValueString())
+func (m TableDataSourceModel) useQuery() string {
+	return fmt.Sprintf("USE %s", quoteIdentifier(revisionDatabase(m.Database, m.Branch)))
 }
 
 func (d *TableDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -57,29 +50,17 @@ func (d *TableDataSource) Schema(ctx context.Context, req datasource.SchemaReque
 				MarkdownDescription: "Name of the database that contains the table",
 				Required:            true,
 			},
+			"branch": schema.StringAttribute{
+				MarkdownDescription: "Branch of the database that contains the table, defaults to the default branch",
+				Optional:            true,
+			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "Name of the table",
 				Required:            true,
 			},
-			"columns": schema.ListNestedAttribute{
-				MarkdownDescription: "Table columns",
-				Computed:            true,
-				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"name": schema.StringAttribute{
-							Computed: true,
-						},
-						"type": schema.StringAttribute{
-							Computed: true,
-						},
-						"key": schema.StringAttribute{
-							Computed: true,
-						},
-					},
-				},
-			},
 		},
 	}
+	maps.Copy(resp.Schema.Attributes, tableMetadataDataSourceAttributes())
 }
 
 func (d *TableDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -87,18 +68,18 @@ func (d *TableDataSource) Configure(ctx context.Context, req datasource.Configur
 		return
 	}
 
-	db, ok := req.ProviderData.(*sql.DB)
+	client, ok := req.ProviderData.(*DoltClient)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *sql.DB, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *DoltClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	d.db = db
+	d.client = client
 }
 
 func (d *TableDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -108,34 +89,37 @@ func (d *TableDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	result, err := d.db.QueryContext(ctx, data.readQuery())
+	conn, err := d.client.db.Conn(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read table, got error: %s", err))
 		return
 	}
-	if !result.Next() {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Cannot find table with name %s", data.Name.ValueString()))
-		return
-	}
-	var columns []types.Object
-	for result.Next() {
-		var name, typ, key string
-		err := result.Scan(&name, &typ, &key)
+	defer conn.Close()
+
+	// Revision databases only show up in INFORMATION_SCHEMA once they have been used on the connection
+	if !data.Branch.IsNull() {
+		_, err = conn.ExecContext(ctx, data.useQuery())
 		if err != nil {
 			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read table, got error: %s", err))
 			return
 		}
-		col, diagnostics := types.ObjectValue(columnType.AttrTypes, map[string]attr.Value{
-			"name": types.StringValue(name),
-			"type": types.StringValue(typ),
-			"key":  types.StringValue(key),
-		})
-		resp.Diagnostics.Append(diagnostics...)
-		columns = append(columns, col)
 	}
-	columnsList, diagnostics := types.ListValueFrom(ctx, columnType, columns)
+
+	metadata, ok, diagnostics := readTableMetadata(ctx, conn, revisionDatabase(data.Database, data.Branch), data.Name.ValueString())
 	resp.Diagnostics.Append(diagnostics...)
-	data.Columns = columnsList
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !ok {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Cannot find table with name %s", data.Name.ValueString()))
+		return
+	}
+	data.Columns = metadata.Columns
+	data.Indexes = metadata.Indexes
+	data.ForeignKeys = metadata.ForeignKeys
+	data.CheckConstraints = metadata.CheckConstraints
+	data.RowCount = metadata.RowCount
+	data.Ddl = metadata.Ddl
 
 	tflog.Trace(ctx, "read a data source")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
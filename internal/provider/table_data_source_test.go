@@ -42,10 +42,26 @@ func TestAccExistingTableDataSource(t *testing.T) {
 					),
 				},
 				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttr("data.dolt_table.test", "columns.#", "1"),
-					resource.TestCheckResourceAttr("data.dolt_table.test", "columns.0.name", "name"),
-					resource.TestCheckResourceAttr("data.dolt_table.test", "columns.0.type", "varchar(100)"),
-					resource.TestCheckResourceAttr("data.dolt_table.test", "columns.0.key", ""),
+					resource.TestCheckResourceAttr("data.dolt_table.test", "columns.#", "2"),
+					resource.TestCheckResourceAttr("data.dolt_table.test", "columns.0.name", "id"),
+					resource.TestCheckResourceAttr("data.dolt_table.test", "columns.0.type", "int"),
+					resource.TestCheckResourceAttr("data.dolt_table.test", "columns.0.key", "PRI"),
+					resource.TestCheckResourceAttr("data.dolt_table.test", "columns.0.nullable", "false"),
+					resource.TestCheckNoResourceAttr("data.dolt_table.test", "columns.0.collation"),
+					resource.TestCheckResourceAttr("data.dolt_table.test", "columns.1.name", "name"),
+					resource.TestCheckResourceAttr("data.dolt_table.test", "columns.1.type", "varchar(100)"),
+					resource.TestCheckResourceAttr("data.dolt_table.test", "columns.1.key", ""),
+					resource.TestCheckResourceAttr("data.dolt_table.test", "columns.1.nullable", "true"),
+					resource.TestCheckNoResourceAttr("data.dolt_table.test", "columns.1.default"),
+					resource.TestCheckResourceAttr("data.dolt_table.test", "columns.1.position", "2"),
+					resource.TestCheckResourceAttr("data.dolt_table.test", "indexes.#", "1"),
+					resource.TestCheckResourceAttr("data.dolt_table.test", "indexes.0.name", "PRIMARY"),
+					resource.TestCheckResourceAttr("data.dolt_table.test", "indexes.0.columns.0", "id"),
+					resource.TestCheckResourceAttr("data.dolt_table.test", "indexes.0.unique", "true"),
+					resource.TestCheckResourceAttr("data.dolt_table.test", "foreign_keys.#", "0"),
+					resource.TestCheckResourceAttr("data.dolt_table.test", "check_constraints.#", "0"),
+					resource.TestCheckResourceAttr("data.dolt_table.test", "row_count", "0"),
+					resource.TestMatchResourceAttr("data.dolt_table.test", "ddl", regexp.MustCompile("^CREATE TABLE `test_table`")),
 				),
 			},
 		},
@@ -69,3 +85,50 @@ data "dolt_table" "test" {
 }
 `
 }
+
+func TestAccTableOnBranchDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() +
+					testAccDatabaseResourceConfig() +
+					testAccBranchResourceConfig() +
+					testAccTableResourceOnBranchConfig() +
+					testAccTableOnBranchDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.dolt_table.branch", "columns.#", "2"),
+					resource.TestCheckResourceAttr("data.dolt_table.branch", "columns.1.name", "name"),
+				),
+			},
+			{
+				Config: testAccProviderConfig() +
+					testAccDatabaseResourceConfig() +
+					testAccBranchResourceConfig() +
+					testAccTableResourceOnBranchConfig() +
+					testAccTableOnDefaultBranchDataSourceConfig(),
+				ExpectError: regexp.MustCompile("Cannot find table with name branch_table"),
+			},
+		},
+	})
+}
+
+func testAccTableOnBranchDataSourceConfig() string {
+	return `
+data "dolt_table" "branch" {
+  database = dolt_table.branch.database
+  branch   = dolt_table.branch.branch
+  name     = dolt_table.branch.name
+}
+`
+}
+
+func testAccTableOnDefaultBranchDataSourceConfig() string {
+	return `
+data "dolt_table" "branch" {
+  database = dolt_table.branch.database
+  name     = dolt_table.branch.name
+}
+`
+}
@@ -0,0 +1,410 @@
+package provider
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var columnType = basetypes.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"name":      types.StringType,
+		"type":      types.StringType,
+		"key":       types.StringType,
+		"nullable":  types.BoolType,
+		"default":   types.StringType,
+		"extra":     types.StringType,
+		"collation": types.StringType,
+		"comment":   types.StringType,
+		"position":  types.Int64Type,
+	},
+}
+
+var indexType = basetypes.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"name":    types.StringType,
+		"columns": types.ListType{ElemType: types.StringType},
+		"unique":  types.BoolType,
+		"type":    types.StringType,
+	},
+}
+
+var foreignKeyType = basetypes.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"name":               types.StringType,
+		"columns":            types.ListType{ElemType: types.StringType},
+		"referenced_table":   types.StringType,
+		"referenced_columns": types.ListType{ElemType: types.StringType},
+		"on_update":          types.StringType,
+		"on_delete":          types.StringType,
+	},
+}
+
+var checkConstraintType = basetypes.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"name":       types.StringType,
+		"expression": types.StringType,
+	},
+}
+
+// computedAttribute describes an attribute that is computed from tableMetadata.
+type computedAttribute struct {
+	description string
+	attrType    attr.Type
+	// nested holds the descriptions of the attributes of the objects in a list of objects.
+	nested map[string]string
+}
+
+// tableMetadataAttributes are the attributes of the table resource and data source that are filled from tableMetadata.
+var tableMetadataAttributes = map[string]computedAttribute{
+	"columns": {
+		description: "Table columns",
+		attrType:    types.ListType{ElemType: columnType},
+		nested: map[string]string{
+			"nullable":  "Whether the column accepts NULL",
+			"default":   "Default value of the column, null if the column has no default",
+			"extra":     "Additional information such as `auto_increment` or how the column is generated",
+			"collation": "Collation of the column, null for non-string columns",
+			"comment":   "Comment of the column",
+			"position":  "Position of the column in the table, starting at 1",
+		},
+	},
+	"indexes": {
+		description: "Table indexes including the primary key",
+		attrType:    types.ListType{ElemType: indexType},
+		nested: map[string]string{
+			"name":    "Name of the index, `PRIMARY` for the primary key",
+			"columns": "Columns of the index",
+			"unique":  "Whether the index is unique",
+			"type":    "Type of the index, e.g. `BTREE`",
+		},
+	},
+	"foreign_keys": {
+		description: "Table foreign keys",
+		attrType:    types.ListType{ElemType: foreignKeyType},
+		nested: map[string]string{
+			"name":               "Name of the foreign key constraint",
+			"columns":            "Columns of the table that reference the other table",
+			"referenced_table":   "Name of the referenced table",
+			"referenced_columns": "Columns of the referenced table",
+			"on_update":          "Action when a referenced row is updated",
+			"on_delete":          "Action when a referenced row is deleted",
+		},
+	},
+	"check_constraints": {
+		description: "Table check constraints",
+		attrType:    types.ListType{ElemType: checkConstraintType},
+		nested: map[string]string{
+			"name":       "Name of the check constraint",
+			"expression": "Expression that is checked",
+		},
+	},
+	"row_count": {
+		description: "Number of rows in the table",
+		attrType:    types.Int64Type,
+	},
+	"ddl": {
+		description: "Statement that creates the table as returned by `SHOW CREATE TABLE`",
+		attrType:    types.StringType,
+	},
+}
+
+// tableMetadataResourceAttributes returns the schema of tableMetadataAttributes for the table resource.
+func tableMetadataResourceAttributes() map[string]resourceschema.Attribute {
+	attributes := map[string]resourceschema.Attribute{}
+	for name, attribute := range tableMetadataAttributes {
+		attributes[name] = computedResourceAttribute(attribute.attrType, attribute.description, attribute.nested)
+	}
+	return attributes
+}
+
+func computedResourceAttribute(attrType attr.Type, description string, nested map[string]string) resourceschema.Attribute {
+	switch attrType := attrType.(type) {
+	case basetypes.ListType:
+		if object, ok := attrType.ElemType.(basetypes.ObjectType); ok {
+			attributes := map[string]resourceschema.Attribute{}
+			for name, nestedType := range object.AttrTypes {
+				attributes[name] = computedResourceAttribute(nestedType, nested[name], nil)
+			}
+			return resourceschema.ListNestedAttribute{
+				MarkdownDescription: description,
+				Computed:            true,
+				NestedObject:        resourceschema.NestedAttributeObject{Attributes: attributes},
+			}
+		}
+		return resourceschema.ListAttribute{MarkdownDescription: description, ElementType: attrType.ElemType, Computed: true}
+	case basetypes.BoolType:
+		return resourceschema.BoolAttribute{MarkdownDescription: description, Computed: true}
+	case basetypes.Int64Type:
+		return resourceschema.Int64Attribute{MarkdownDescription: description, Computed: true}
+	default:
+		return resourceschema.StringAttribute{MarkdownDescription: description, Computed: true}
+	}
+}
+
+// tableMetadataDataSourceAttributes returns the schema of tableMetadataAttributes for the table data source.
+func tableMetadataDataSourceAttributes() map[string]datasourceschema.Attribute {
+	attributes := map[string]datasourceschema.Attribute{}
+	for name, attribute := range tableMetadataAttributes {
+		attributes[name] = computedDataSourceAttribute(attribute.attrType, attribute.description, attribute.nested)
+	}
+	return attributes
+}
+
+func computedDataSourceAttribute(attrType attr.Type, description string, nested map[string]string) datasourceschema.Attribute {
+	switch attrType := attrType.(type) {
+	case basetypes.ListType:
+		if object, ok := attrType.ElemType.(basetypes.ObjectType); ok {
+			attributes := map[string]datasourceschema.Attribute{}
+			for name, nestedType := range object.AttrTypes {
+				attributes[name] = computedDataSourceAttribute(nestedType, nested[name], nil)
+			}
+			return datasourceschema.ListNestedAttribute{
+				MarkdownDescription: description,
+				Computed:            true,
+				NestedObject:        datasourceschema.NestedAttributeObject{Attributes: attributes},
+			}
+		}
+		return datasourceschema.ListAttribute{MarkdownDescription: description, ElementType: attrType.ElemType, Computed: true}
+	case basetypes.BoolType:
+		return datasourceschema.BoolAttribute{MarkdownDescription: description, Computed: true}
+	case basetypes.Int64Type:
+		return datasourceschema.Int64Attribute{MarkdownDescription: description, Computed: true}
+	default:
+		return datasourceschema.StringAttribute{MarkdownDescription: description, Computed: true}
+	}
+}
+
+// tableMetadata is the schema of a table as reported by INFORMATION_SCHEMA, shared by the table resource and data source.
+type tableMetadata struct {
+	Columns          types.List
+	Indexes          types.List
+	ForeignKeys      types.List
+	CheckConstraints types.List
+	RowCount         types.Int64
+	Ddl              types.String
+}
+
+// readTableMetadata reads the metadata of a table in the given database, which can be a revision database.
+// It returns false if the table does not exist.
+func readTableMetadata(ctx context.Context, conn *sql.Conn, database, table string) (tableMetadata, bool, diag.Diagnostics) {
+	var metadata tableMetadata
+	var diags diag.Diagnostics
+
+	columns, err := readTableColumns(ctx, conn, database, table)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read table, got error: %s", err))
+		return metadata, false, diags
+	}
+	if len(columns) == 0 {
+		return metadata, false, diags
+	}
+	indexes, err := readTableIndexes(ctx, conn, database, table)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read table indexes, got error: %s", err))
+		return metadata, true, diags
+	}
+	foreignKeys, err := readTableForeignKeys(ctx, conn, database, table)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read table foreign keys, got error: %s", err))
+		return metadata, true, diags
+	}
+	checkConstraints, err := readTableCheckConstraints(ctx, conn, database, table)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read table check constraints, got error: %s", err))
+		return metadata, true, diags
+	}
+
+	var rowCount int64
+	var ddl string
+	err = conn.QueryRowContext(ctx, fmt.Sprintf("SELECT COUNT(*) FROM %s", qualifiedName(database, table))).Scan(&rowCount)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to count table rows, got error: %s", err))
+		return metadata, true, diags
+	}
+	var name string
+	err = conn.QueryRowContext(ctx, fmt.Sprintf("SHOW CREATE TABLE %s", qualifiedName(database, table))).Scan(&name, &ddl)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read table definition, got error: %s", err))
+		return metadata, true, diags
+	}
+
+	var d diag.Diagnostics
+	metadata.Columns, d = types.ListValue(columnType, columns)
+	diags.Append(d...)
+	metadata.Indexes, d = types.ListValue(indexType, indexes)
+	diags.Append(d...)
+	metadata.ForeignKeys, d = types.ListValue(foreignKeyType, foreignKeys)
+	diags.Append(d...)
+	metadata.CheckConstraints, d = types.ListValue(checkConstraintType, checkConstraints)
+	diags.Append(d...)
+	metadata.RowCount = types.Int64Value(rowCount)
+	metadata.Ddl = types.StringValue(ddl)
+	return metadata, true, diags
+}
+
+func readTableColumns(ctx context.Context, conn *sql.Conn, database, table string) ([]attr.Value, error) {
+	result, err := conn.QueryContext(ctx, `
+		SELECT COLUMN_NAME, COLUMN_TYPE, COLUMN_KEY, IS_NULLABLE, COLUMN_DEFAULT, EXTRA, COLLATION_NAME, COLUMN_COMMENT, ORDINAL_POSITION
+		FROM INFORMATION_SCHEMA.COLUMNS
+		WHERE TABLE_SCHEMA = ? AND TABLE_NAME = ?
+		ORDER BY ORDINAL_POSITION`, database, table)
+	if err != nil {
+		return nil, err
+	}
+	defer result.Close()
+	var columns []attr.Value
+	for result.Next() {
+		var name, typ, key, nullable, extra, comment string
+		var defaultValue, collation sql.NullString
+		var position int64
+		err := result.Scan(&name, &typ, &key, &nullable, &defaultValue, &extra, &collation, &comment, &position)
+		if err != nil {
+			return nil, err
+		}
+		column, diags := types.ObjectValue(columnType.AttrTypes, map[string]attr.Value{
+			"name":      types.StringValue(name),
+			"type":      types.StringValue(typ),
+			"key":       types.StringValue(key),
+			"nullable":  types.BoolValue(nullable == "YES"),
+			"default":   nullString(defaultValue),
+			"extra":     types.StringValue(extra),
+			"collation": nullString(collation),
+			"comment":   types.StringValue(comment),
+			"position":  types.Int64Value(position),
+		})
+		if diags.HasError() {
+			return nil, fmt.Errorf("cannot convert column %s", name)
+		}
+		columns = append(columns, column)
+	}
+	return columns, result.Err()
+}
+
+func readTableIndexes(ctx context.Context, conn *sql.Conn, database, table string) ([]attr.Value, error) {
+	result, err := conn.QueryContext(ctx, `
+		SELECT INDEX_NAME, NON_UNIQUE, COLUMN_NAME, INDEX_TYPE
+		FROM INFORMATION_SCHEMA.STATISTICS
+		WHERE TABLE_SCHEMA = ? AND TABLE_NAME = ?
+		ORDER BY INDEX_NAME, SEQ_IN_INDEX`, database, table)
+	if err != nil {
+		return nil, err
+	}
+	defer result.Close()
+	var names []string
+	indexes := map[string]map[string]attr.Value{}
+	columns := map[string][]attr.Value{}
+	for result.Next() {
+		var name, column, typ string
+		var nonUnique int64
+		err := result.Scan(&name, &nonUnique, &column, &typ)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := indexes[name]; !ok {
+			names = append(names, name)
+			indexes[name] = map[string]attr.Value{
+				"name":   types.StringValue(name),
+				"unique": types.BoolValue(nonUnique == 0),
+				"type":   types.StringValue(typ),
+			}
+		}
+		columns[name] = append(columns[name], types.StringValue(column))
+	}
+	if err := result.Err(); err != nil {
+		return nil, err
+	}
+	var values []attr.Value
+	for _, name := range names {
+		indexes[name]["columns"] = types.ListValueMust(types.StringType, columns[name])
+		values = append(values, types.ObjectValueMust(indexType.AttrTypes, indexes[name]))
+	}
+	return values, nil
+}
+
+func readTableForeignKeys(ctx context.Context, conn *sql.Conn, database, table string) ([]attr.Value, error) {
+	result, err := conn.QueryContext(ctx, `
+		SELECT k.CONSTRAINT_NAME, k.COLUMN_NAME, k.REFERENCED_TABLE_NAME, k.REFERENCED_COLUMN_NAME, r.UPDATE_RULE, r.DELETE_RULE
+		FROM INFORMATION_SCHEMA.KEY_COLUMN_USAGE k
+		JOIN INFORMATION_SCHEMA.REFERENTIAL_CONSTRAINTS r
+			ON r.CONSTRAINT_SCHEMA = k.CONSTRAINT_SCHEMA AND r.CONSTRAINT_NAME = k.CONSTRAINT_NAME
+		WHERE k.TABLE_SCHEMA = ? AND k.TABLE_NAME = ?
+		ORDER BY k.CONSTRAINT_NAME, k.ORDINAL_POSITION`, database, table)
+	if err != nil {
+		return nil, err
+	}
+	defer result.Close()
+	var names []string
+	foreignKeys := map[string]map[string]attr.Value{}
+	columns := map[string][]attr.Value{}
+	referencedColumns := map[string][]attr.Value{}
+	for result.Next() {
+		var name, column, referencedTable, referencedColumn, onUpdate, onDelete string
+		err := result.Scan(&name, &column, &referencedTable, &referencedColumn, &onUpdate, &onDelete)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := foreignKeys[name]; !ok {
+			names = append(names, name)
+			foreignKeys[name] = map[string]attr.Value{
+				"name":             types.StringValue(name),
+				"referenced_table": types.StringValue(referencedTable),
+				"on_update":        types.StringValue(onUpdate),
+				"on_delete":        types.StringValue(onDelete),
+			}
+		}
+		columns[name] = append(columns[name], types.StringValue(column))
+		referencedColumns[name] = append(referencedColumns[name], types.StringValue(referencedColumn))
+	}
+	if err := result.Err(); err != nil {
+		return nil, err
+	}
+	var values []attr.Value
+	for _, name := range names {
+		foreignKeys[name]["columns"] = types.ListValueMust(types.StringType, columns[name])
+		foreignKeys[name]["referenced_columns"] = types.ListValueMust(types.StringType, referencedColumns[name])
+		values = append(values, types.ObjectValueMust(foreignKeyType.AttrTypes, foreignKeys[name]))
+	}
+	return values, nil
+}
+
+func readTableCheckConstraints(ctx context.Context, conn *sql.Conn, database, table string) ([]attr.Value, error) {
+	result, err := conn.QueryContext(ctx, `
+		SELECT tc.CONSTRAINT_NAME, cc.CHECK_CLAUSE
+		FROM INFORMATION_SCHEMA.TABLE_CONSTRAINTS tc
+		JOIN INFORMATION_SCHEMA.CHECK_CONSTRAINTS cc
+			ON cc.CONSTRAINT_SCHEMA = tc.CONSTRAINT_SCHEMA AND cc.CONSTRAINT_NAME = tc.CONSTRAINT_NAME
+		WHERE tc.TABLE_SCHEMA = ? AND tc.TABLE_NAME = ? AND tc.CONSTRAINT_TYPE = 'CHECK'
+		ORDER BY tc.CONSTRAINT_NAME`, database, table)
+	if err != nil {
+		return nil, err
+	}
+	defer result.Close()
+	var values []attr.Value
+	for result.Next() {
+		var name, expression string
+		err := result.Scan(&name, &expression)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, types.ObjectValueMust(checkConstraintType.AttrTypes, map[string]attr.Value{
+			"name":       types.StringValue(name),
+			"expression": types.StringValue(expression),
+		}))
+	}
+	return values, result.Err()
+}
+
+func nullString(value sql.NullString) types.String {
+	if !value.Valid {
+		return types.StringNull()
+	}
+	return types.StringValue(value.String)
+}
@@ -2,9 +2,7 @@ package provider
 
 import (
 	"context"
-	"database/sql"
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -13,44 +11,86 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"maps"
+	"strings"
 )
 
 var _ resource.Resource = &TableResource{}
 var _ resource.ResourceWithImportState = &TableResource{}
+var _ resource.ResourceWithValidateConfig = &TableResource{}
+var _ resource.ResourceWithModifyPlan = &TableResource{}
 
 func NewTableResource() resource.Resource {
 	return &TableResource{}
 }
 
 type TableResource struct {
-	db *sql.DB
+	client *DoltClient
 }
 
 type TableResourceModel struct {
+	Id       types.String `tfsdk:"id"`
 	Database types.String `tfsdk:"database"`
+	Branch   types.String `tfsdk:"branch"`
 	Name     types.String `tfsdk:"name"`
 	Query    types.String `tfsdk:"query"`
 	Columns  types.List   `tfsdk:"columns"`
+
+	Indexes          types.List   `tfsdk:"indexes"`
+	ForeignKeys      types.List   `tfsdk:"foreign_keys"`
+	CheckConstraints types.List   `tfsdk:"check_constraints"`
+	RowCount         types.Int64  `tfsdk:"row_count"`
+	Ddl              types.String `tfsdk:"ddl"`
+
+	Column     []TableColumnModel     `tfsdk:"column"`
+	PrimaryKey *TablePrimaryKeyModel  `tfsdk:"primary_key"`
+	Index      []TableIndexModel      `tfsdk:"index"`
+	ForeignKey []TableForeignKeyModel `tfsdk:"foreign_key"`
+}
+
+func (m TableResourceModel) id() string {
+	return m.Database.ValueString() + "/" + m.Name.ValueString()
 }
 
 func (m TableResourceModel) useQuery() string {
-	return fmt.Sprintf("USE %s", m.Database.ValueString())
+	return fmt.Sprintf("USE %s", quoteIdentifier(revisionDatabase(m.Database, m.Branch)))
+}
+
+func (m TableResourceModel) commitMessage(operation string) commitMessage {
+	return commitMessage{
+		Type:      "dolt_table",
+		Database:  m.Database.ValueString(),
+		Address:   m.Name.ValueString(),
+		Operation: operation,
+	}
 }
 
 func (m TableResourceModel) createQuery() string {
 	return m.Query.ValueString()
 }
 
-func (m TableResourceModel) readQuery() string {
-	return fmt.Sprintf(`
-		SELECT COLUMN_NAME, COLUMN_TYPE, COLUMN_KEY
-		FROM INFORMATION_SCHEMA.COLUMNS
-		WHERE `+"`"+`TABLE_SCHEMA`+"`"+` = '%s' AND `+"`"+`TABLE_NAME`+"`"+` = '%s'
-		ORDER BY ORDINAL_POSITION`, m.Database.ValueString(), m.Name.ValueString())
+func (m TableResourceModel) showCreateQuery() string {
+	return fmt.Sprintf("SHOW CREATE TABLE %s", qualifiedName(revisionDatabase(m.Database, m.Branch), m.Name.ValueString()))
+}
+
+// alterQueries returns the ALTER TABLE statements that turn the table created by the query in state into the one in m.
+func (m TableResourceModel) alterQueries(state TableResourceModel) ([]string, error) {
+	if m.Query.Equal(state.Query) {
+		return nil, nil
+	}
+	statements, err := alterTableStatements(state.Query.ValueString(), m.Query.ValueString())
+	if err != nil {
+		return nil, err
+	}
+	var queries []string
+	for _, statement := range statements {
+		queries = append(queries, fmt.Sprintf("ALTER TABLE %s %s", quoteIdentifier(m.Name.ValueString()), statement))
+	}
+	return queries, nil
 }
 
 func (m TableResourceModel) deleteQuery() string {
-	return fmt.Sprintf("DROP TABLE %s", m.Name.ValueString())
+	return fmt.Sprintf("DROP TABLE %s", quoteIdentifier(m.Name.ValueString()))
 }
 
 func (r *TableResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -62,6 +102,13 @@ func (r *TableResource) Schema(ctx context.Context, req resource.SchemaRequest,
 		MarkdownDescription: "Table resource",
 
 		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Table identifier in the format `database/table`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"database": schema.StringAttribute{
 				MarkdownDescription: "Name of the database that contains the table",
 				Required:            true,
@@ -69,38 +116,89 @@ func (r *TableResource) Schema(ctx context.Context, req resource.SchemaRequest,
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
-			"name": schema.StringAttribute{
-				MarkdownDescription: "Name of the table, not confirming equality with table created by query",
-				Required:            true,
+			"branch": schema.StringAttribute{
+				MarkdownDescription: "Branch of the database that contains the table, defaults to the default branch",
+				Optional:            true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
-			"query": schema.StringAttribute{
-				MarkdownDescription: "Query to create the table",
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the table, not confirming equality with table created by query",
 				Required:            true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
-			"columns": schema.ListNestedAttribute{
-				MarkdownDescription: "Table columns",
-				Computed:            true,
-				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"name": schema.StringAttribute{
-							Computed: true,
-						},
-						"type": schema.StringAttribute{
-							Computed: true,
-						},
-						"key": schema.StringAttribute{
-							Computed: true,
-						},
-					},
+			"query": schema.StringAttribute{
+				MarkdownDescription: "Query to create the table. Changes are applied with `ALTER TABLE` where possible, " +
+					"the table is only replaced if the change cannot be expressed that way, e.g. when columns are reordered. " +
+					"A column that is replaced by one with the same definition at the same position is renamed. " +
+					"Generated from the `column`, `primary_key`, `index` and `foreign_key` blocks if not configured",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					tableQueryRequiresReplace(),
 				},
 			},
 		},
+
+		Blocks: tableBlocks(),
+	}
+	maps.Copy(resp.Schema.Attributes, tableMetadataResourceAttributes())
+}
+
+func (r *TableResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data TableResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateBlocks(data)...)
+}
+
+// ModifyPlan generates the query from the blocks if the query is not configured directly.
+// Like changes of a configured query, changes of the blocks only replace the table if they cannot be applied with ALTER TABLE.
+func (r *TableResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var configQuery types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("query"), &configQuery)...)
+	if resp.Diagnostics.HasError() || !configQuery.IsNull() {
+		return
+	}
+
+	var data TableResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query, ok := data.blocksQuery()
+	if !ok {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("query"), types.StringUnknown())...)
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("query"), query)...)
+
+	if req.State.Raw.IsNull() {
+		return
+	}
+	var state TableResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if state.Query.ValueString() == query {
+		return
+	}
+	_, err := alterTableStatements(state.Query.ValueString(), query)
+	if err != nil {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("query"))
+		resp.Diagnostics.Append(tableReplaceWarning(err))
 	}
 }
 
@@ -109,18 +207,18 @@ func (r *TableResource) Configure(ctx context.Context, req resource.ConfigureReq
 		return
 	}
 
-	db, ok := req.ProviderData.(*sql.DB)
+	client, ok := req.ProviderData.(*DoltClient)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *sql.DB, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *DoltClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.db = db
+	r.client = client
 }
 
 func (r *TableResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -130,7 +228,7 @@ func (r *TableResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	tx, err := r.db.BeginTx(ctx, nil)
+	tx, err := r.client.beginTx(ctx, data.commitMessage("create"))
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create table, got error: %s", err))
 		return
@@ -154,9 +252,13 @@ func (r *TableResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	if r.fillData(ctx, &data, resp.Diagnostics) {
+	if !r.fillData(ctx, &data, &resp.Diagnostics) {
+		if !resp.Diagnostics.HasError() {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Cannot find table with name %s", data.Name.ValueString()))
+		}
 		return
 	}
+	data.Id = types.StringValue(data.id())
 
 	tflog.Trace(ctx, "created a resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -169,20 +271,71 @@ func (r *TableResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	if r.fillData(ctx, &data, resp.Diagnostics) {
+	found := r.fillData(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		tflog.Warn(ctx, fmt.Sprintf("Table %s no longer exists, removing it from state", data.Name.ValueString()))
+		resp.State.RemoveResource(ctx)
 		return
 	}
+	data.Id = types.StringValue(data.id())
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *TableResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data TableResourceModel
+	var data, state TableResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	alterQueries, err := data.alterQueries(state)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update table, got error: %s", err))
+		return
+	}
+
+	if len(alterQueries) > 0 {
+		tx, err := r.client.beginTx(ctx, data.commitMessage("update"))
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update table, got error: %s", err))
+			return
+		}
+
+		_, err = tx.ExecContext(ctx, data.useQuery())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update table, got error: %s", err))
+			return
+		}
+
+		for _, alterQuery := range alterQueries {
+			_, err = tx.ExecContext(ctx, alterQuery)
+			if err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update table, got error: %s", err))
+				return
+			}
+		}
+
+		err = tx.Commit()
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update table, got error: %s", err))
+			return
+		}
+	}
+
+	if !r.fillData(ctx, &data, &resp.Diagnostics) {
+		if !resp.Diagnostics.HasError() {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Cannot find table with name %s", data.Name.ValueString()))
+		}
+		return
+	}
+	data.Id = types.StringValue(data.id())
+
+	tflog.Trace(ctx, "updated a table")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -193,7 +346,7 @@ func (r *TableResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	tx, err := r.db.BeginTx(ctx, nil)
+	tx, err := r.client.beginTx(ctx, data.commitMessage("delete"))
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete table, got error: %s", err))
 		return
@@ -221,37 +374,93 @@ func (r *TableResource) Delete(ctx context.Context, req resource.DeleteRequest,
 }
 
 func (r *TableResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	database, name, ok := strings.Cut(req.ID, "/")
+	if !ok || database == "" || name == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: database/table. Got: %q", req.ID),
+		)
+		return
+	}
+
+	data := TableResourceModel{
+		Database: types.StringValue(database),
+		Branch:   types.StringNull(),
+		Name:     types.StringValue(name),
+	}
+	var table, query string
+	err := r.client.db.QueryRowContext(ctx, data.showCreateQuery()).Scan(&table, &query)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import table, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), data.id())...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("database"), database)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("query"), query)...)
+}
+
+// tableQueryRequiresReplace replaces the table only if the change of the query cannot be applied with ALTER TABLE.
+func tableQueryRequiresReplace() planmodifier.String {
+	return stringplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+			// Queries generated from blocks are only known after ModifyPlan, which decides about the replacement itself
+			if req.PlanValue.IsUnknown() {
+				return
+			}
+			_, err := alterTableStatements(req.StateValue.ValueString(), req.PlanValue.ValueString())
+			if err != nil {
+				resp.RequiresReplace = true
+				resp.Diagnostics.Append(tableReplaceWarning(err))
+			}
+		},
+		"The table is replaced if the change of the query cannot be applied with ALTER TABLE.",
+		"The table is replaced if the change of the query cannot be applied with `ALTER TABLE`.",
+	)
 }
 
-func (r *TableResource) fillData(ctx context.Context, data *TableResourceModel, diag diag.Diagnostics) bool {
-	result, err := r.db.QueryContext(ctx, data.readQuery())
+// tableReplaceWarning warns that replacing the table deletes its rows, e.g. when columns are reordered.
+func tableReplaceWarning(err error) diag.Diagnostic {
+	return diag.NewAttributeWarningDiagnostic(
+		path.Root("query"),
+		"Table Replacement",
+		fmt.Sprintf("The table is dropped and created again, which deletes all of its rows: %s.", err),
+	)
+}
+
+// fillData reads the table into data and returns whether it exists. Failing queries are reported in diag.
+func (r *TableResource) fillData(ctx context.Context, data *TableResourceModel, diag *diag.Diagnostics) bool {
+	conn, err := r.client.db.Conn(ctx)
 	if err != nil {
 		diag.AddError("Client Error", fmt.Sprintf("Unable to read table, got error: %s", err))
-		return true
-	}
-	if !result.Next() {
-		diag.AddError("Client Error", fmt.Sprintf("Cannot find table with name %s", data.Name.ValueString()))
-		return true
+		return false
 	}
-	var columns []types.Object
-	for result.Next() {
-		var name, typ, key string
-		err := result.Scan(&name, &typ, &key)
+	defer conn.Close()
+
+	// Revision databases only show up in INFORMATION_SCHEMA once they have been used on the connection
+	if !data.Branch.IsNull() {
+		_, err = conn.ExecContext(ctx, data.useQuery())
 		if err != nil {
 			diag.AddError("Client Error", fmt.Sprintf("Unable to read table, got error: %s", err))
-			return true
+			return false
 		}
-		col, diagnostics := types.ObjectValue(columnType.AttrTypes, map[string]attr.Value{
-			"name": types.StringValue(name),
-			"type": types.StringValue(typ),
-			"key":  types.StringValue(key),
-		})
-		diag.Append(diagnostics...)
-		columns = append(columns, col)
-	}
-	columnsList, diagnostics := types.ListValueFrom(ctx, columnType, columns)
+	}
+
+	metadata, ok, diagnostics := readTableMetadata(ctx, conn, revisionDatabase(data.Database, data.Branch), data.Name.ValueString())
 	diag.Append(diagnostics...)
-	data.Columns = columnsList
-	return false
+	if diag.HasError() {
+		return false
+	}
+	if !ok {
+		return false
+	}
+	data.Columns = metadata.Columns
+	data.Indexes = metadata.Indexes
+	data.ForeignKeys = metadata.ForeignKeys
+	data.CheckConstraints = metadata.CheckConstraints
+	data.RowCount = metadata.RowCount
+	data.Ddl = metadata.Ddl
+	diag.Append(data.checkColumns()...)
+	return true
 }
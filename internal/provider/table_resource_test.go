@@ -1,9 +1,14 @@
 package provider
 
 import (
+	"fmt"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 func TestAccTableResource(t *testing.T) {
@@ -15,7 +20,51 @@ func TestAccTableResource(t *testing.T) {
 				Config: testAccProviderConfig() +
 					testAccDatabaseResourceConfig() +
 					testAccTableResourceConfig(),
-				Check: resource.ComposeAggregateTestCheckFunc(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dolt_table.test", "id", "test/test_table"),
+				),
+			},
+			{
+				ResourceName:            "dolt_table.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"query"},
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					query := states[0].Attributes["query"]
+					if !strings.HasPrefix(query, "CREATE TABLE `test_table`") {
+						return fmt.Errorf("expected imported query to create test_table, got: %s", query)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccTableResourceDropped(t *testing.T) {
+	host, port := testAccDoltServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderServerConfig(host, port) +
+					testAccDatabaseResourceConfig() +
+					testAccTableResourceConfig(),
+			},
+			{
+				PreConfig: func() {
+					testAccServerExec(t, host, port, "DROP TABLE test.test_table")
+				},
+				Config: testAccProviderServerConfig(host, port) +
+					testAccDatabaseResourceConfig() +
+					testAccTableResourceConfig(),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("dolt_table.test", plancheck.ResourceActionCreate),
+					},
+				},
 			},
 		},
 	})
@@ -36,3 +85,282 @@ EOF
 }
 `
 }
+
+func TestAccTableResourceOnBranch(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() +
+					testAccDatabaseResourceConfig() +
+					testAccBranchResourceConfig() +
+					testAccTableResourceOnBranchConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dolt_table.branch", "branch", "test_branch"),
+					resource.TestCheckResourceAttr("dolt_table.branch", "columns.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTableResourceOnBranchConfig() string {
+	return `
+resource "dolt_table" "branch" {
+  database = dolt_database.test.name
+  branch   = dolt_branch.test.name
+
+  name  = "branch_table"
+  query = <<EOF
+CREATE TABLE branch_table (
+	id INT PRIMARY KEY,
+	name VARCHAR(100)
+);
+EOF
+}
+`
+}
+
+func TestAccTableResourceAlter(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() +
+					testAccDatabaseResourceConfig() +
+					testAccTableResourceConfig() +
+					testAccRowSetResourceConfigTwo(),
+			},
+			{
+				Config: testAccProviderConfig() +
+					testAccDatabaseResourceConfig() +
+					testAccTableResourceAlterConfig() +
+					testAccRowSetResourceConfigTwo(),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("dolt_table.test", plancheck.ResourceActionUpdate),
+						plancheck.ExpectResourceAction("dolt_rowset.test", plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("dolt_table.test", "columns.*", map[string]string{
+						"name": "email",
+						"type": "varchar(100)",
+					}),
+					resource.TestCheckResourceAttr("dolt_rowset.test", "row_count", "2"),
+					resource.TestCheckResourceAttr("dolt_rowset.test", "values.1.1", "Alice"),
+				),
+			},
+			{
+				PreConfig: func() {
+					testAccDoltExec(t, "test", "UPDATE test_table SET email = 'alice@example.com' WHERE id = 1")
+				},
+				Config: testAccProviderConfig() +
+					testAccDatabaseResourceConfig() +
+					testAccTableResourceRenameConfig() +
+					testAccRowSetResourceConfigTwo() +
+					testAccTableResourceRenameQueryConfig(),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("dolt_table.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.dolt_query.test", "rows.0.contact", "alice@example.com"),
+					resource.TestCheckResourceAttr("dolt_rowset.test", "row_count", "2"),
+				),
+			},
+			{
+				Config: testAccProviderConfig() +
+					testAccDatabaseResourceConfig() +
+					testAccTableResourceReorderConfig(),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("dolt_table.test", plancheck.ResourceActionReplace),
+					},
+				},
+			},
+		},
+	})
+}
+
+func testAccTableResourceAlterConfig() string {
+	return `
+resource "dolt_table" "test" {
+  database = dolt_database.test.name
+
+  name  = "test_table"
+  query = <<EOF
+CREATE TABLE test_table (
+	id INT PRIMARY KEY,
+	name VARCHAR(100),
+	email VARCHAR(100),
+	INDEX idx_name (name)
+);
+EOF
+}
+`
+}
+
+func testAccTableResourceRenameConfig() string {
+	return `
+resource "dolt_table" "test" {
+  database = dolt_database.test.name
+
+  name  = "test_table"
+  query = <<EOF
+CREATE TABLE test_table (
+	id INT PRIMARY KEY,
+	name VARCHAR(100),
+	contact VARCHAR(100),
+	INDEX idx_name (name)
+) COMMENT='Test users';
+EOF
+}
+`
+}
+
+func testAccTableResourceRenameQueryConfig() string {
+	return `
+data "dolt_query" "test" {
+  database = dolt_table.test.database
+  query    = "SELECT contact FROM test_table WHERE id = 1"
+}
+`
+}
+
+func testAccTableResourceReorderConfig() string {
+	return `
+resource "dolt_table" "test" {
+  database = dolt_database.test.name
+
+  name  = "test_table"
+  query = <<EOF
+CREATE TABLE test_table (
+	id INT PRIMARY KEY,
+	contact VARCHAR(100),
+	name VARCHAR(100)
+);
+EOF
+}
+`
+}
+
+func TestAccTableResourceBlocks(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + `
+resource "dolt_table" "blocks" {
+  database = "test"
+  name     = "articles"
+}
+`,
+				ExpectError: regexp.MustCompile("Missing Table Definition"),
+			},
+			{
+				Config: testAccProviderConfig() +
+					testAccDatabaseResourceConfig() +
+					testAccTableResourceConfig() +
+					testAccTableResourceBlocksConfig(""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dolt_table.blocks", "columns.#", "4"),
+					resource.TestCheckResourceAttr("dolt_table.blocks", "columns.0.key", "PRI"),
+					resource.TestCheckResourceAttr("dolt_table.blocks", "columns.0.extra", "auto_increment"),
+					resource.TestCheckResourceAttr("dolt_table.blocks", "columns.1.comment", "Title of the article"),
+					resource.TestCheckResourceAttr("dolt_table.blocks", "columns.2.default", "draft"),
+					resource.TestCheckResourceAttr("dolt_table.blocks", "columns.2.nullable", "false"),
+					resource.TestCheckResourceAttr("dolt_table.blocks", "indexes.#", "3"),
+					resource.TestCheckResourceAttr("dolt_table.blocks", "foreign_keys.#", "1"),
+					resource.TestCheckResourceAttr("dolt_table.blocks", "foreign_keys.0.name", "fk_author"),
+					resource.TestCheckResourceAttr("dolt_table.blocks", "foreign_keys.0.referenced_table", "test_table"),
+					resource.TestCheckResourceAttr("dolt_table.blocks", "foreign_keys.0.on_delete", "CASCADE"),
+					resource.TestCheckResourceAttr("dolt_table.blocks", "query", "CREATE TABLE `articles` (\n"+
+						"  `id` INT NOT NULL AUTO_INCREMENT,\n"+
+						"  `title` VARCHAR(100) NOT NULL COMMENT 'Title of the article',\n"+
+						"  `status` VARCHAR(10) NOT NULL DEFAULT 'draft',\n"+
+						"  `author_id` INT,\n"+
+						"  PRIMARY KEY (`id`),\n"+
+						"  UNIQUE KEY `idx_title` (`title`),\n"+
+						"  CONSTRAINT `fk_author` FOREIGN KEY (`author_id`) REFERENCES `test_table` (`id`) ON DELETE CASCADE\n"+
+						");"),
+				),
+			},
+			{
+				Config: testAccProviderConfig() +
+					testAccDatabaseResourceConfig() +
+					testAccTableResourceConfig() +
+					testAccTableResourceBlocksConfig(`
+  column {
+    name = "summary"
+    type = "TEXT"
+  }
+`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("dolt_table.blocks", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dolt_table.blocks", "columns.#", "5"),
+					resource.TestCheckResourceAttr("dolt_table.blocks", "columns.4.name", "summary"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTableResourceBlocksConfig(extraColumns string) string {
+	return `
+resource "dolt_table" "blocks" {
+  database = dolt_database.test.name
+
+  name = "articles"
+
+  column {
+    name           = "id"
+    type           = "INT"
+    nullable       = false
+    auto_increment = true
+  }
+  column {
+    name     = "title"
+    type     = "VARCHAR(100)"
+    nullable = false
+    comment  = "Title of the article"
+  }
+  column {
+    name     = "status"
+    type     = "VARCHAR(10)"
+    nullable = false
+    default  = "'draft'"
+  }
+  column {
+    name = "author_id"
+    type = "INT"
+  }
+` + extraColumns + `
+  primary_key {
+    columns = ["id"]
+  }
+
+  index {
+    name    = "idx_title"
+    columns = ["title"]
+    unique  = true
+  }
+
+  foreign_key {
+    name               = "fk_author"
+    columns            = ["author_id"]
+    referenced_table   = dolt_table.test.name
+    referenced_columns = ["id"]
+    on_delete          = "CASCADE"
+  }
+}
+`
+}
@@ -0,0 +1,296 @@
+package provider
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &TagResource{}
+var _ resource.ResourceWithImportState = &TagResource{}
+
+func NewTagResource() resource.Resource {
+	return &TagResource{}
+}
+
+type TagResource struct {
+	client *DoltClient
+}
+
+type TagResourceModel struct {
+	Database   types.String `tfsdk:"database"`
+	Name       types.String `tfsdk:"name"`
+	Ref        types.String `tfsdk:"ref"`
+	Message    types.String `tfsdk:"message"`
+	CommitHash types.String `tfsdk:"commit_hash"`
+	Tagger     types.String `tfsdk:"tagger"`
+	Date       types.String `tfsdk:"date"`
+}
+
+func (m TagResourceModel) useQuery() string {
+	return fmt.Sprintf("USE %s", quoteIdentifier(m.Database.ValueString()))
+}
+
+func (m TagResourceModel) commitMessage(operation string) commitMessage {
+	return commitMessage{
+		Type:      "dolt_tag",
+		Database:  m.Database.ValueString(),
+		Address:   m.Name.ValueString(),
+		Operation: operation,
+	}
+}
+
+func (m TagResourceModel) createQuery() (string, []any) {
+	if m.Message.IsNull() {
+		return "CALL DOLT_TAG(?, ?)", []any{m.Name.ValueString(), m.Ref.ValueString()}
+	}
+	return "CALL DOLT_TAG(?, ?, '-m', ?)", []any{m.Name.ValueString(), m.Ref.ValueString(), m.Message.ValueString()}
+}
+
+func (m TagResourceModel) readQuery() (string, []any) {
+	return fmt.Sprintf("SELECT tag_hash, tagger, email, date, message FROM %s WHERE tag_name = ?", qualifiedName(m.Database.ValueString(), "dolt_tags")), []any{m.Name.ValueString()}
+}
+
+func (m TagResourceModel) deleteQuery() (string, []any) {
+	return "CALL DOLT_TAG('-d', ?)", []any{m.Name.ValueString()}
+}
+
+func (r *TagResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tag"
+}
+
+func (r *TagResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Tag resource",
+
+		Attributes: map[string]schema.Attribute{
+			"database": schema.StringAttribute{
+				MarkdownDescription: "Name of the database that contains the tag",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the tag",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ref": schema.StringAttribute{
+				MarkdownDescription: "Branch, tag or commit hash the tag points to, set to the commit hash when imported",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"message": schema.StringAttribute{
+				MarkdownDescription: "Message of the annotated tag",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"commit_hash": schema.StringAttribute{
+				MarkdownDescription: "Hash of the commit the tag points to",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"tagger": schema.StringAttribute{
+				MarkdownDescription: "Name and email of the tagger",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"date": schema.StringAttribute{
+				MarkdownDescription: "Date the tag was created, in RFC 3339 format",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *TagResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*DoltClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *DoltClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *TagResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data TagResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tx, err := r.client.beginTx(ctx, data.commitMessage("create"))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create tag, got error: %s", err))
+		return
+	}
+
+	_, err = tx.ExecContext(ctx, data.useQuery())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create tag, got error: %s", err))
+		return
+	}
+
+	createQuery, args := data.createQuery()
+	_, err = tx.ExecContext(ctx, createQuery, args...)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create tag, got error: %s", err))
+		return
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create tag, got error: %s", err))
+		return
+	}
+
+	found, err := r.fillData(ctx, &data)
+	if err == nil && !found {
+		err = fmt.Errorf("tag %s was not created", data.Name.ValueString())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read tag, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created a tag")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TagResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data TagResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	found, err := r.fillData(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read tag, got error: %s", err))
+		return
+	}
+	if !found {
+		tflog.Warn(ctx, fmt.Sprintf("Tag %s no longer exists, removing it from state", data.Name.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TagResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data TagResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TagResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data TagResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tx, err := r.client.beginTx(ctx, data.commitMessage("delete"))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete tag, got error: %s", err))
+		return
+	}
+
+	_, err = tx.ExecContext(ctx, data.useQuery())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete tag, got error: %s", err))
+		return
+	}
+
+	deleteQuery, args := data.deleteQuery()
+	_, err = tx.ExecContext(ctx, deleteQuery, args...)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete tag, got error: %s", err))
+		return
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete tag, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a tag")
+}
+
+func (r *TagResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	database, name, ok := strings.Cut(req.ID, "/")
+	if !ok || database == "" || name == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: database/tag. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("database"), database)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+}
+
+func (r *TagResource) fillData(ctx context.Context, data *TagResourceModel) (bool, error) {
+	var hash, tagger, email, message string
+	var date time.Time
+	readQuery, args := data.readQuery()
+	err := r.client.db.QueryRowContext(ctx, readQuery, args...).Scan(&hash, &tagger, &email, &date, &message)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	data.CommitHash = types.StringValue(hash)
+	data.Tagger = types.StringValue(fmt.Sprintf("%s <%s>", tagger, email))
+	data.Date = types.StringValue(date.UTC().Format(time.RFC3339))
+	if message != "" || !data.Message.IsNull() {
+		data.Message = types.StringValue(message)
+	}
+	if data.Ref.IsNull() {
+		data.Ref = types.StringValue(hash)
+	}
+	return true, nil
+}
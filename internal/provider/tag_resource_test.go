@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTagResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() +
+					testAccDatabaseResourceConfig() +
+					testAccBranchResourceConfig() +
+					testAccTagResourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dolt_tag.test", "name", "v1.0.0"),
+					resource.TestCheckResourceAttr("dolt_tag.test", "message", "First release"),
+					resource.TestCheckResourceAttr("dolt_tag.test", "tagger", "Test Example <test@example.com>"),
+					resource.TestCheckResourceAttrPair("dolt_tag.test", "commit_hash", "dolt_branch.test", "head"),
+					resource.TestCheckResourceAttrSet("dolt_tag.test", "date"),
+				),
+			},
+			{
+				ResourceName:                         "dolt_tag.test",
+				ImportState:                          true,
+				ImportStateId:                        "test/v1.0.0",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+				ImportStateVerifyIgnore:              []string{"ref"},
+			},
+		},
+	})
+}
+
+func testAccTagResourceConfig() string {
+	return `
+resource "dolt_tag" "test" {
+  database = dolt_database.test.name
+
+  name    = "v1.0.0"
+  ref     = dolt_branch.test.name
+  message = "First release"
+}
+`
+}
@@ -2,13 +2,16 @@ package provider
 
 import (
 	"context"
-	"database/sql"
 	"fmt"
+	"github.com/dolthub/vitess/go/vt/sqlparser"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"strings"
 )
 
 var _ resource.Resource = &ViewResource{}
@@ -19,25 +22,51 @@ func NewViewResource() resource.Resource {
 }
 
 type ViewResource struct {
-	db *sql.DB
+	client *DoltClient
 }
 
 type ViewResourceModel struct {
+	Id       types.String `tfsdk:"id"`
 	Database types.String `tfsdk:"database"`
+	Branch   types.String `tfsdk:"branch"`
 	Name     types.String `tfsdk:"name"`
 	Query    types.String `tfsdk:"query"`
 }
 
+func (m ViewResourceModel) id() string {
+	return m.Database.ValueString() + "/" + m.Name.ValueString()
+}
+
 func (m ViewResourceModel) useQuery() string {
-	return fmt.Sprintf("USE %s", m.Database.ValueString())
+	return fmt.Sprintf("USE %s", quoteIdentifier(revisionDatabase(m.Database, m.Branch)))
+}
+
+func (m ViewResourceModel) commitMessage(operation string) commitMessage {
+	return commitMessage{
+		Type:      "dolt_view",
+		Database:  m.Database.ValueString(),
+		Address:   m.Name.ValueString(),
+		Operation: operation,
+	}
 }
 
 func (m ViewResourceModel) createUpdateQuery() string {
-	return fmt.Sprintf("CREATE OR REPLACE VIEW %s AS %s", m.Name.ValueString(), m.Query.ValueString())
+	return fmt.Sprintf("CREATE OR REPLACE VIEW %s AS %s", quoteIdentifier(m.Name.ValueString()), m.Query.ValueString())
+}
+
+func (m ViewResourceModel) existsQuery() (string, []any) {
+	return `
+		SELECT COUNT(*)
+		FROM INFORMATION_SCHEMA.TABLES
+		WHERE TABLE_SCHEMA = ? AND TABLE_NAME = ? AND TABLE_TYPE = 'VIEW'`, []any{revisionDatabase(m.Database, m.Branch), m.Name.ValueString()}
+}
+
+func (m ViewResourceModel) showCreateQuery() string {
+	return fmt.Sprintf("SHOW CREATE VIEW %s", quoteIdentifier(m.Name.ValueString()))
 }
 
 func (m ViewResourceModel) deleteQuery() string {
-	return fmt.Sprintf("DROP VIEW %s", m.Name.ValueString())
+	return fmt.Sprintf("DROP VIEW %s", quoteIdentifier(m.Name.ValueString()))
 }
 
 func (r *ViewResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -49,16 +78,36 @@ func (r *ViewResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 		MarkdownDescription: "View resource",
 
 		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "View identifier in the format `database/view`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"database": schema.StringAttribute{
 				MarkdownDescription: "Name of the database that contains the view",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"branch": schema.StringAttribute{
+				MarkdownDescription: "Branch of the database that contains the view, defaults to the default branch",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "Name of the view",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"query": schema.StringAttribute{
-				MarkdownDescription: "Select query used to populate the view rows",
+				MarkdownDescription: "Select query used to populate the view rows. Differences in formatting to the definition of the view are ignored",
 				Required:            true,
 			},
 		},
@@ -70,18 +119,18 @@ func (r *ViewResource) Configure(ctx context.Context, req resource.ConfigureRequ
 		return
 	}
 
-	db, ok := req.ProviderData.(*sql.DB)
+	client, ok := req.ProviderData.(*DoltClient)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *sql.DB, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *DoltClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.db = db
+	r.client = client
 }
 
 func (r *ViewResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -91,7 +140,7 @@ func (r *ViewResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
-	tx, err := r.db.BeginTx(ctx, nil)
+	tx, err := r.client.beginTx(ctx, data.commitMessage("create"))
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create view, got error: %s", err))
 		return
@@ -115,6 +164,8 @@ func (r *ViewResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
+	data.Id = types.StringValue(data.id())
+
 	tflog.Trace(ctx, "created a resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -126,6 +177,54 @@ func (r *ViewResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	conn, err := r.client.db.Conn(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read view, got error: %s", err))
+		return
+	}
+	defer conn.Close()
+
+	// Revision databases only show up in INFORMATION_SCHEMA once they have been used on the connection
+	if !data.Branch.IsNull() {
+		_, err = conn.ExecContext(ctx, data.useQuery())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read view, got error: %s", err))
+			return
+		}
+	}
+
+	existsQuery, args := data.existsQuery()
+	var count int
+	err = conn.QueryRowContext(ctx, existsQuery, args...).Scan(&count)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read view, got error: %s", err))
+		return
+	}
+	if count == 0 {
+		tflog.Warn(ctx, fmt.Sprintf("View %s no longer exists, removing it from state", data.Name.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// SHOW CREATE VIEW only accepts views in the current database
+	_, err = conn.ExecContext(ctx, data.useQuery())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read view, got error: %s", err))
+		return
+	}
+
+	var view, createView, characterSet, collation string
+	err = conn.QueryRowContext(ctx, data.showCreateQuery()).Scan(&view, &createView, &characterSet, &collation)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read view, got error: %s", err))
+		return
+	}
+	query := viewQuery(createView)
+	if normalizeViewQuery(query) != normalizeViewQuery(data.Query.ValueString()) {
+		data.Query = types.StringValue(query)
+	}
+	data.Id = types.StringValue(data.id())
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -135,7 +234,7 @@ func (r *ViewResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	tx, err := r.db.BeginTx(ctx, nil)
+	tx, err := r.client.beginTx(ctx, data.commitMessage("update"))
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update view, got error: %s", err))
 		return
@@ -159,6 +258,8 @@ func (r *ViewResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	data.Id = types.StringValue(data.id())
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -169,7 +270,7 @@ func (r *ViewResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
-	tx, err := r.db.BeginTx(ctx, nil)
+	tx, err := r.client.beginTx(ctx, data.commitMessage("delete"))
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete view, got error: %s", err))
 		return
@@ -197,5 +298,63 @@ func (r *ViewResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 }
 
 func (r *ViewResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	database, name, ok := strings.Cut(req.ID, "/")
+	if !ok || database == "" || name == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: database/view. Got: %q", req.ID),
+		)
+		return
+	}
+
+	data := ViewResourceModel{
+		Database: types.StringValue(database),
+		Branch:   types.StringNull(),
+		Name:     types.StringValue(name),
+	}
+	conn, err := r.client.db.Conn(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import view, got error: %s", err))
+		return
+	}
+	defer conn.Close()
+
+	_, err = conn.ExecContext(ctx, data.useQuery())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import view, got error: %s", err))
+		return
+	}
+
+	var view, createView, characterSet, collation string
+	err = conn.QueryRowContext(ctx, data.showCreateQuery()).Scan(&view, &createView, &characterSet, &collation)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import view, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), data.id())...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("database"), database)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("query"), viewQuery(createView))...)
+}
+
+// viewQuery extracts the select query from the CREATE VIEW statement returned by SHOW CREATE VIEW.
+func viewQuery(createView string) string {
+	_, query, ok := strings.Cut(createView, " AS ")
+	if !ok {
+		return createView
+	}
+	return query
+}
+
+// normalizeViewQuery formats a select query so that queries that only differ in whitespace, letter case of keywords,
+// quoting of identifiers or a trailing semicolon compare as equal. Queries that cannot be parsed only have their
+// whitespace normalized.
+func normalizeViewQuery(query string) string {
+	query = strings.TrimSuffix(strings.TrimSpace(query), ";")
+	statement, err := sqlparser.Parse(query)
+	if err != nil {
+		return strings.Join(strings.Fields(query), " ")
+	}
+	return sqlparser.String(statement)
 }
@@ -1,9 +1,16 @@
 package provider
 
 import (
+	"database/sql"
+	"fmt"
+	"net"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 )
 
 func TestAccViewResource(t *testing.T) {
@@ -16,7 +23,22 @@ func TestAccViewResource(t *testing.T) {
 					testAccDatabaseResourceConfig() +
 					testAccTableResourceConfig() +
 					testAccViewResourceConfig(),
-				Check: resource.ComposeAggregateTestCheckFunc(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dolt_view.test", "id", "test/test_view"),
+				),
+			},
+			{
+				ResourceName:            "dolt_view.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"query"},
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					query := states[0].Attributes["query"]
+					if query != "SELECT name FROM test_table" {
+						return fmt.Errorf("expected imported query to select from test_table, got: %s", query)
+					}
+					return nil
+				},
 			},
 		},
 	})
@@ -38,3 +60,144 @@ EOF
 }
 `
 }
+
+func TestAccViewResourceDrift(t *testing.T) {
+	host, port := testAccDoltServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderServerConfig(host, port) +
+					testAccDatabaseResourceConfig() +
+					testAccTableResourceConfig() +
+					testAccViewResourceConfig(),
+			},
+			{
+				PreConfig: func() {
+					testAccServerExec(t, host, port, "USE test", "CREATE OR REPLACE VIEW test_view AS SELECT id FROM test_table")
+				},
+				Config: testAccProviderServerConfig(host, port) +
+					testAccDatabaseResourceConfig() +
+					testAccTableResourceConfig() +
+					testAccViewResourceConfig(),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("dolt_view.test", plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue("dolt_view.test", tfjsonpath.New("id"), knownvalue.StringExact("test/test_view")),
+					},
+				},
+			},
+			{
+				PreConfig: func() {
+					testAccServerExec(t, host, port, "USE test", "DROP VIEW test_view")
+				},
+				Config: testAccProviderServerConfig(host, port) +
+					testAccDatabaseResourceConfig() +
+					testAccTableResourceConfig() +
+					testAccViewResourceConfig(),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("dolt_view.test", plancheck.ResourceActionCreate),
+					},
+				},
+			},
+			{
+				Config: testAccProviderServerConfig(host, port) +
+					testAccDatabaseResourceConfig() +
+					testAccTableResourceConfig() +
+					testAccViewResourceRenamedConfig(),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("dolt_view.test", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: func(s *terraform.State) error {
+					db, err := sql.Open("mysql", fmt.Sprintf("root@tcp(%s)/", net.JoinHostPort(host, port)))
+					if err != nil {
+						return err
+					}
+					defer db.Close()
+					var count int
+					err = db.QueryRow("SELECT COUNT(*) FROM INFORMATION_SCHEMA.TABLES WHERE TABLE_SCHEMA = 'test' AND TABLE_NAME = 'test_view'").Scan(&count)
+					if err != nil {
+						return err
+					}
+					if count != 0 {
+						return fmt.Errorf("expected test_view to be dropped after the rename")
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func testAccViewResourceRenamedConfig() string {
+	return `
+resource "dolt_view" "test" {
+  depends_on = [
+    dolt_table.test
+  ]
+
+  database = dolt_database.test.name
+
+  name  = "renamed_view"
+  query = <<EOF
+SELECT name FROM test_table
+EOF
+}
+`
+}
+
+func TestAccViewResourceOnBranch(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() +
+					testAccDatabaseResourceConfig() +
+					testAccBranchResourceConfig() +
+					testAccTableResourceOnBranchConfig() +
+					testAccViewResourceOnBranchConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dolt_view.branch", "branch", "test_branch"),
+				),
+			},
+		},
+	})
+}
+
+func testAccViewResourceOnBranchConfig() string {
+	return `
+resource "dolt_view" "branch" {
+  database = dolt_database.test.name
+  branch   = dolt_table.branch.branch
+
+  name  = "branch_view"
+  query = <<EOF
+SELECT name FROM ${dolt_table.branch.name}
+EOF
+}
+`
+}
+
+func TestNormalizeViewQuery(t *testing.T) {
+	tests := []struct {
+		a, b  string
+		equal bool
+	}{
+		{"SELECT name FROM test_table\n", "SELECT name FROM test_table", true},
+		{"select name\nfrom test_table;", "SELECT `name` FROM `test_table`", true},
+		{"SELECT name FROM test_table", "SELECT id FROM test_table", false},
+		{"SELECT 'A  B' FROM test_table", "SELECT 'A B' FROM test_table", false},
+	}
+	for _, test := range tests {
+		equal := normalizeViewQuery(test.a) == normalizeViewQuery(test.b)
+		if equal != test.equal {
+			t.Errorf("expected %q and %q to be equal: %t, got %t", test.a, test.b, test.equal, equal)
+		}
+	}
+}